@@ -18,6 +18,22 @@ func CIDR(i interface{}, k string) (warnings []string, errors []error) {
 	return warnings, errors
 }
 
+// CIDRsOverlap returns whether the two specified CIDRs overlap - CIDRs from different address families
+// (e.g. an IPv4 and an IPv6 CIDR) never overlap
+func CIDRsOverlap(first, second string) (bool, error) {
+	_, firstNet, err := net.ParseCIDR(first)
+	if err != nil {
+		return false, fmt.Errorf("parsing %q as a CIDR: %+v", first, err)
+	}
+
+	_, secondNet, err := net.ParseCIDR(second)
+	if err != nil {
+		return false, fmt.Errorf("parsing %q as a CIDR: %+v", second, err)
+	}
+
+	return firstNet.Contains(secondNet.IP) || secondNet.Contains(firstNet.IP), nil
+}
+
 func IPv4Address(i interface{}, k string) (warnings []string, errors []error) {
 	return validateIpv4Address(i, k, false)
 }
@@ -43,6 +43,81 @@ func TestCIDR(t *testing.T) {
 	}
 }
 
+func TestCIDRsOverlap(t *testing.T) {
+	cases := []struct {
+		First    string
+		Second   string
+		Expected bool
+		Error    bool
+	}{
+		{
+			First:    "10.0.0.0/16",
+			Second:   "10.1.0.0/16",
+			Expected: false,
+		},
+		{
+			First:    "10.0.0.0/16",
+			Second:   "10.0.2.0/24",
+			Expected: true,
+		},
+		{
+			First:    "10.0.2.0/24",
+			Second:   "10.0.0.0/16",
+			Expected: true,
+		},
+		{
+			First:    "10.0.0.0/24",
+			Second:   "10.0.0.0/24",
+			Expected: true,
+		},
+		{
+			First:    "10.0.0.0/25",
+			Second:   "10.0.0.128/25",
+			Expected: false,
+		},
+		{
+			First:    "ace:cab:deca::/48",
+			Second:   "ace:cab:deca:deed::/64",
+			Expected: true,
+		},
+		{
+			First:    "0.0.0.0/0",
+			Second:   "::/0",
+			Expected: false,
+		},
+		{
+			First:  "10.0.0.0",
+			Second: "10.0.0.0/16",
+			Error:  true,
+		},
+		{
+			First:  "10.0.0.0/16",
+			Second: "",
+			Error:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.First+" "+tc.Second, func(t *testing.T) {
+			actual, err := CIDRsOverlap(tc.First, tc.Second)
+			if err != nil {
+				if tc.Error {
+					return
+				}
+
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+			if tc.Error {
+				t.Fatalf("Expected an error but didn't get one")
+			}
+
+			if actual != tc.Expected {
+				t.Fatalf("Expected %t but got %t", tc.Expected, actual)
+			}
+		})
+	}
+}
+
 func TestIPv4Address(t *testing.T) {
 	cases := []struct {
 		IP     string
@@ -18,12 +18,14 @@ func Default() UserFeatures {
 			RecoverSoftDeletedKeys:           true,
 			RecoverSoftDeletedCerts:          true,
 			RecoverSoftDeletedSecrets:        true,
+			IgnoreUnreachableDataPlaneOnRead: false,
 		},
 		LogAnalyticsWorkspace: LogAnalyticsWorkspaceFeatures{
 			PermanentlyDeleteOnDestroy: false,
 		},
 		Network: NetworkFeatures{
-			RelaxedLocking: false,
+			RelaxedLocking:              false,
+			BatchSecurityRuleOperations: false,
 		},
 		ResourceGroup: ResourceGroupFeatures{
 			PreventDeletionIfContainsResources: false,
@@ -35,6 +37,7 @@ func Default() UserFeatures {
 			DeleteOSDiskOnDeletion:     true,
 			GracefulShutdown:           false,
 			SkipShutdownAndForceDelete: false,
+			StartEvictedSpotInstances:  false,
 		},
 		VirtualMachineScaleSet: VirtualMachineScaleSetFeatures{
 			ForceDelete:               false,
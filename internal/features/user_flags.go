@@ -20,6 +20,7 @@ type VirtualMachineFeatures struct {
 	DeleteOSDiskOnDeletion     bool
 	GracefulShutdown           bool
 	SkipShutdownAndForceDelete bool
+	StartEvictedSpotInstances  bool
 }
 
 type VirtualMachineScaleSetFeatures struct {
@@ -37,10 +38,12 @@ type KeyVaultFeatures struct {
 	RecoverSoftDeletedKeys           bool
 	RecoverSoftDeletedCerts          bool
 	RecoverSoftDeletedSecrets        bool
+	IgnoreUnreachableDataPlaneOnRead bool
 }
 
 type NetworkFeatures struct {
-	RelaxedLocking bool
+	RelaxedLocking              bool
+	BatchSecurityRuleOperations bool
 }
 
 type TemplateDeploymentFeatures struct {
@@ -54,6 +54,10 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 						Type:     pluginsdk.TypeBool,
 						Optional: true,
 					},
+					"ignore_unreachable_data_plane_on_read": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
 				},
 			},
 		},
@@ -82,6 +86,11 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 						Type:     pluginsdk.TypeBool,
 						Required: true,
 					},
+
+					"batch_security_rule_operations": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
 				},
 			},
 		},
@@ -119,6 +128,10 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 						Type:     schema.TypeBool,
 						Optional: true,
 					},
+					"start_evicted_spot_instances": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
 				},
 			},
 		},
@@ -274,6 +287,9 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			if v, ok := keyVaultRaw["recover_soft_deleted_key_vaults"]; ok {
 				featuresMap.KeyVault.RecoverSoftDeletedKeyVaults = v.(bool)
 			}
+			if v, ok := keyVaultRaw["ignore_unreachable_data_plane_on_read"]; ok {
+				featuresMap.KeyVault.IgnoreUnreachableDataPlaneOnRead = v.(bool)
+			}
 			// Inherit Key Vault recovery setting by default. If we're on 3.0 then the code below will overwrite
 			// these values as needed.
 			// TODO: Remove in 3.0
@@ -325,6 +341,9 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			if v, ok := networkRaw["relaxed_locking"]; ok {
 				featuresMap.Network.RelaxedLocking = v.(bool)
 			}
+			if v, ok := networkRaw["batch_security_rule_operations"]; ok {
+				featuresMap.Network.BatchSecurityRuleOperations = v.(bool)
+			}
 		}
 	}
 
@@ -351,6 +370,9 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			if v, ok := virtualMachinesRaw["skip_shutdown_and_force_delete"]; ok {
 				featuresMap.VirtualMachine.SkipShutdownAndForceDelete = v.(bool)
 			}
+			if v, ok := virtualMachinesRaw["start_evicted_spot_instances"]; ok {
+				featuresMap.VirtualMachine.StartEvictedSpotInstances = v.(bool)
+			}
 		}
 	}
 
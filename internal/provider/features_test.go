@@ -33,6 +33,7 @@ func TestExpandFeatures(t *testing.T) {
 					RecoverSoftDeletedKeys:           true,
 					RecoverSoftDeletedKeyVaults:      true,
 					RecoverSoftDeletedSecrets:        true,
+					IgnoreUnreachableDataPlaneOnRead: false,
 				},
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: false,
@@ -47,6 +48,7 @@ func TestExpandFeatures(t *testing.T) {
 					DeleteOSDiskOnDeletion:     true,
 					GracefulShutdown:           false,
 					SkipShutdownAndForceDelete: false,
+					StartEvictedSpotInstances:  false,
 				},
 				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
 					ForceDelete:               false,
@@ -82,6 +84,7 @@ func TestExpandFeatures(t *testing.T) {
 							"recover_soft_deleted_keys":                  true,
 							"recover_soft_deleted_key_vaults":            true,
 							"recover_soft_deleted_secrets":               true,
+							"ignore_unreachable_data_plane_on_read":      true,
 						},
 					},
 					"log_analytics_workspace": []interface{}{
@@ -91,7 +94,8 @@ func TestExpandFeatures(t *testing.T) {
 					},
 					"network": []interface{}{
 						map[string]interface{}{
-							"relaxed_locking": true,
+							"relaxed_locking":                true,
+							"batch_security_rule_operations": true,
 						},
 					},
 					"resource_group": []interface{}{
@@ -109,6 +113,7 @@ func TestExpandFeatures(t *testing.T) {
 							"delete_os_disk_on_deletion":     true,
 							"graceful_shutdown":              true,
 							"skip_shutdown_and_force_delete": true,
+							"start_evicted_spot_instances":   true,
 						},
 					},
 					"virtual_machine_scale_set": []interface{}{
@@ -136,12 +141,14 @@ func TestExpandFeatures(t *testing.T) {
 					RecoverSoftDeletedKeys:           true,
 					RecoverSoftDeletedKeyVaults:      true,
 					RecoverSoftDeletedSecrets:        true,
+					IgnoreUnreachableDataPlaneOnRead: true,
 				},
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: true,
 				},
 				Network: features.NetworkFeatures{
-					RelaxedLocking: true,
+					RelaxedLocking:              true,
+					BatchSecurityRuleOperations: true,
 				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: true,
@@ -153,6 +160,7 @@ func TestExpandFeatures(t *testing.T) {
 					DeleteOSDiskOnDeletion:     true,
 					GracefulShutdown:           true,
 					SkipShutdownAndForceDelete: true,
+					StartEvictedSpotInstances:  true,
 				},
 				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
 					RollInstancesWhenRequired: true,
@@ -185,6 +193,7 @@ func TestExpandFeatures(t *testing.T) {
 							"recover_soft_deleted_keys":                  false,
 							"recover_soft_deleted_key_vaults":            false,
 							"recover_soft_deleted_secrets":               false,
+							"ignore_unreachable_data_plane_on_read":      false,
 						},
 					},
 					"log_analytics_workspace": []interface{}{
@@ -194,7 +203,8 @@ func TestExpandFeatures(t *testing.T) {
 					},
 					"network_locking": []interface{}{
 						map[string]interface{}{
-							"relaxed_locking": false,
+							"relaxed_locking":                false,
+							"batch_security_rule_operations": false,
 						},
 					},
 					"resource_group": []interface{}{
@@ -212,6 +222,7 @@ func TestExpandFeatures(t *testing.T) {
 							"delete_os_disk_on_deletion":     false,
 							"graceful_shutdown":              false,
 							"skip_shutdown_and_force_delete": false,
+							"start_evicted_spot_instances":   false,
 						},
 					},
 					"virtual_machine_scale_set": []interface{}{
@@ -239,12 +250,14 @@ func TestExpandFeatures(t *testing.T) {
 					RecoverSoftDeletedKeys:           false,
 					RecoverSoftDeletedKeyVaults:      false,
 					RecoverSoftDeletedSecrets:        false,
+					IgnoreUnreachableDataPlaneOnRead: false,
 				},
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: false,
 				},
 				Network: features.NetworkFeatures{
-					RelaxedLocking: false,
+					RelaxedLocking:              false,
+					BatchSecurityRuleOperations: false,
 				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: false,
@@ -256,6 +269,7 @@ func TestExpandFeatures(t *testing.T) {
 					DeleteOSDiskOnDeletion:     false,
 					GracefulShutdown:           false,
 					SkipShutdownAndForceDelete: false,
+					StartEvictedSpotInstances:  false,
 				},
 				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
 					ForceDelete:               false,
@@ -429,6 +443,7 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 					RecoverSoftDeletedKeys:           true,
 					RecoverSoftDeletedKeyVaults:      true,
 					RecoverSoftDeletedSecrets:        true,
+					IgnoreUnreachableDataPlaneOnRead: false,
 				},
 			},
 		},
@@ -446,6 +461,7 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 							"recover_soft_deleted_keys":                  true,
 							"recover_soft_deleted_key_vaults":            true,
 							"recover_soft_deleted_secrets":               true,
+							"ignore_unreachable_data_plane_on_read":      true,
 						},
 					},
 				},
@@ -460,6 +476,7 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 					RecoverSoftDeletedKeys:           true,
 					RecoverSoftDeletedKeyVaults:      true,
 					RecoverSoftDeletedSecrets:        true,
+					IgnoreUnreachableDataPlaneOnRead: true,
 				},
 			},
 		},
@@ -477,6 +494,7 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 							"recover_soft_deleted_keys":                  false,
 							"recover_soft_deleted_key_vaults":            false,
 							"recover_soft_deleted_secrets":               false,
+							"ignore_unreachable_data_plane_on_read":      false,
 						},
 					},
 				},
@@ -491,6 +509,7 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 					RecoverSoftDeletedKeyVaults:      false,
 					RecoverSoftDeletedKeys:           false,
 					RecoverSoftDeletedSecrets:        false,
+					IgnoreUnreachableDataPlaneOnRead: false,
 				},
 			},
 		},
@@ -559,6 +578,42 @@ func TestExpandFeaturesNetwork(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Batch Security Rule Operations Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"network": []interface{}{
+						map[string]interface{}{
+							"relaxed_locking":                false,
+							"batch_security_rule_operations": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Network: features.NetworkFeatures{
+					BatchSecurityRuleOperations: true,
+				},
+			},
+		},
+		{
+			Name: "Batch Security Rule Operations Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"network": []interface{}{
+						map[string]interface{}{
+							"relaxed_locking":                false,
+							"batch_security_rule_operations": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Network: features.NetworkFeatures{
+					BatchSecurityRuleOperations: false,
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testData {
@@ -654,6 +709,7 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 					DeleteOSDiskOnDeletion:     true,
 					GracefulShutdown:           false,
 					SkipShutdownAndForceDelete: false,
+					StartEvictedSpotInstances:  false,
 				},
 			},
 		},
@@ -676,6 +732,7 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 					DeleteOSDiskOnDeletion:     true,
 					GracefulShutdown:           false,
 					SkipShutdownAndForceDelete: false,
+					StartEvictedSpotInstances:  false,
 				},
 			},
 		},
@@ -697,6 +754,7 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 					DeleteOSDiskOnDeletion:     false,
 					GracefulShutdown:           true,
 					SkipShutdownAndForceDelete: false,
+					StartEvictedSpotInstances:  false,
 				},
 			},
 		},
@@ -718,6 +776,30 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 					DeleteOSDiskOnDeletion:     false,
 					GracefulShutdown:           false,
 					SkipShutdownAndForceDelete: true,
+					StartEvictedSpotInstances:  false,
+				},
+			},
+		},
+		{
+			Name: "Start Evicted Spot Instances Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"virtual_machine": []interface{}{
+						map[string]interface{}{
+							"delete_os_disk_on_deletion":     false,
+							"graceful_shutdown":              false,
+							"skip_shutdown_and_force_delete": false,
+							"start_evicted_spot_instances":   true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				VirtualMachine: features.VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion:     false,
+					GracefulShutdown:           false,
+					SkipShutdownAndForceDelete: false,
+					StartEvictedSpotInstances:  true,
 				},
 			},
 		},
@@ -730,6 +812,7 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 							"delete_os_disk_on_deletion":     false,
 							"graceful_shutdown":              false,
 							"skip_shutdown_and_force_delete": false,
+							"start_evicted_spot_instances":   false,
 						},
 					},
 				},
@@ -739,6 +822,7 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 					DeleteOSDiskOnDeletion:     false,
 					GracefulShutdown:           false,
 					SkipShutdownAndForceDelete: false,
+					StartEvictedSpotInstances:  false,
 				},
 			},
 		},
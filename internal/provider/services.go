@@ -115,7 +115,9 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		eventhub.Registration{},
 		loadbalancer.Registration{},
 		mssql.Registration{},
+		network.Registration{},
 		policy.Registration{},
+		privatedns.Registration{},
 		resource.Registration{},
 		sentinel.Registration{},
 		servicefabricmanaged.Registration{},
@@ -0,0 +1,303 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCapacityReservation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCapacityReservationCreate,
+		Read:   resourceCapacityReservationRead,
+		Update: resourceCapacityReservationUpdate,
+		Delete: resourceCapacityReservationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.CapacityReservationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CapacityReservationName,
+			},
+
+			"capacity_reservation_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CapacityReservationGroupID,
+			},
+
+			"sku": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						// the quantity of the reservation can be changed in-place
+						"capacity": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"zone": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceCapacityReservationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.CapacityReservationsClient
+	groupsClient := meta.(*clients.Client).Compute.CapacityReservationGroupsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	groupId, err := parse.CapacityReservationGroupID(d.Get("capacity_reservation_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewCapacityReservationID(groupId.SubscriptionId, groupId.ResourceGroup, groupId.Name, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.CapacityReservationGroupName, id.Name, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_capacity_reservation", id.ID())
+	}
+
+	// the Capacity Reservation must be in the same location as the Capacity Reservation Group
+	group, err := groupsClient.Get(ctx, groupId.ResourceGroup, groupId.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *groupId, err)
+	}
+
+	parameters := compute.CapacityReservation{
+		Location: group.Location,
+		Sku:      expandCapacityReservationSku(d.Get("sku").([]interface{})),
+		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("zone"); ok {
+		parameters.Zones = &[]string{
+			v.(string),
+		}
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.CapacityReservationGroupName, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceCapacityReservationRead(d, meta)
+}
+
+func resourceCapacityReservationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.CapacityReservationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CapacityReservationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.CapacityReservationGroupName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("capacity_reservation_group_id", parse.NewCapacityReservationGroupID(id.SubscriptionId, id.ResourceGroup, id.CapacityReservationGroupName).ID())
+
+	if err := d.Set("sku", flattenCapacityReservationSku(resp.Sku)); err != nil {
+		return fmt.Errorf("setting `sku`: %+v", err)
+	}
+
+	zone := ""
+	if resp.Zones != nil && len(*resp.Zones) > 0 {
+		zone = (*resp.Zones)[0]
+	}
+	d.Set("zone", zone)
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceCapacityReservationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.CapacityReservationsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CapacityReservationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := compute.CapacityReservationUpdate{}
+
+	if d.HasChange("sku") {
+		parameters.Sku = expandCapacityReservationSku(d.Get("sku").([]interface{}))
+	}
+
+	if d.HasChange("tags") {
+		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.CapacityReservationGroupName, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+	}
+
+	return resourceCapacityReservationRead(d, meta)
+}
+
+func resourceCapacityReservationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.CapacityReservationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CapacityReservationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// a Capacity Reservation can't be deleted whilst Virtual Machines are associated with it - since the association
+	// is only removed some time after the Virtual Machine has been deleted/dissociated we need to wait for this first
+	log.Printf("[DEBUG] Waiting for the Virtual Machines associated with %s to be dissociated..", *id)
+	timeout, _ := ctx.Deadline()
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"Associated"},
+		Target:     []string{"Dissociated"},
+		Refresh:    capacityReservationVirtualMachinesAssociatedRefreshFunc(ctx, client, *id),
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(timeout),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the Virtual Machines associated with %s to be dissociated: %+v", *id, err)
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.CapacityReservationGroupName, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func capacityReservationVirtualMachinesAssociatedRefreshFunc(ctx context.Context, client *compute.CapacityReservationsClient, id parse.CapacityReservationId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id.ResourceGroup, id.CapacityReservationGroupName, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return resp, "Dissociated", nil
+			}
+
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if props := resp.CapacityReservationProperties; props != nil && props.VirtualMachinesAssociated != nil && len(*props.VirtualMachinesAssociated) > 0 {
+			return resp, "Associated", nil
+		}
+
+		return resp, "Dissociated", nil
+	}
+}
+
+func expandCapacityReservationSku(input []interface{}) *compute.Sku {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &compute.Sku{
+		Name:     utils.String(raw["name"].(string)),
+		Capacity: utils.Int64(int64(raw["capacity"].(int))),
+	}
+}
+
+func flattenCapacityReservationSku(input *compute.Sku) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	capacity := 0
+	if input.Capacity != nil {
+		capacity = int(*input.Capacity)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":     name,
+			"capacity": capacity,
+		},
+	}
+}
@@ -0,0 +1,161 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CapacityReservationResource struct{}
+
+func TestAccCapacityReservation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_capacity_reservation", "test")
+	r := CapacityReservationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCapacityReservation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_capacity_reservation", "test")
+	r := CapacityReservationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccCapacityReservation_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_capacity_reservation", "test")
+	r := CapacityReservationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("zone").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCapacityReservation_updateCapacity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_capacity_reservation", "test")
+	r := CapacityReservationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku.0.capacity").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku.0.capacity").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, 0),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku.0.capacity").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (CapacityReservationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.CapacityReservationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Compute.CapacityReservationsClient.Get(ctx, id.ResourceGroup, id.CapacityReservationGroupName, id.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r CapacityReservationResource) basic(data acceptance.TestData, capacity int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_capacity_reservation" "test" {
+  name                          = "acctest-ccr-%d"
+  capacity_reservation_group_id = azurerm_capacity_reservation_group.test.id
+
+  sku {
+    name     = "Standard_F2"
+    capacity = %d
+  }
+}
+`, CapacityReservationGroupResource{}.basic(data), data.RandomInteger, capacity)
+}
+
+func (r CapacityReservationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_capacity_reservation" "import" {
+  name                          = azurerm_capacity_reservation.test.name
+  capacity_reservation_group_id = azurerm_capacity_reservation.test.capacity_reservation_group_id
+
+  sku {
+    name     = "Standard_F2"
+    capacity = 1
+  }
+}
+`, r.basic(data, 1))
+}
+
+func (r CapacityReservationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_capacity_reservation" "test" {
+  name                          = "acctest-ccr-%d"
+  capacity_reservation_group_id = azurerm_capacity_reservation_group.test.id
+  zone                          = "1"
+
+  sku {
+    name     = "Standard_F2"
+    capacity = 2
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, CapacityReservationGroupResource{}.complete(data), data.RandomInteger)
+}
@@ -0,0 +1,172 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type GalleryApplicationResource struct{}
+
+func TestAccGalleryApplication_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_gallery_application", "test")
+	r := GalleryApplicationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGalleryApplication_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_gallery_application", "test")
+	r := GalleryApplicationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccGalleryApplication_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_gallery_application", "test")
+	r := GalleryApplicationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGalleryApplication_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_gallery_application", "test")
+	r := GalleryApplicationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (GalleryApplicationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.GalleryApplicationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Compute.GalleryApplicationsClient.Get(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (GalleryApplicationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_shared_image_gallery" "test" {
+  name                = "acctestsig%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r GalleryApplicationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_gallery_application" "test" {
+  name              = "acctest-app-%d"
+  gallery_id        = azurerm_shared_image_gallery.test.id
+  location          = azurerm_resource_group.test.location
+  supported_os_type = "Linux"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r GalleryApplicationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_gallery_application" "import" {
+  name              = azurerm_gallery_application.test.name
+  gallery_id        = azurerm_gallery_application.test.gallery_id
+  location          = azurerm_gallery_application.test.location
+  supported_os_type = azurerm_gallery_application.test.supported_os_type
+}
+`, r.basic(data))
+}
+
+func (r GalleryApplicationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_gallery_application" "test" {
+  name                  = "acctest-app-%d"
+  gallery_id            = azurerm_shared_image_gallery.test.id
+  location              = azurerm_resource_group.test.location
+  supported_os_type     = "Linux"
+  description           = "This is the gallery application description."
+  end_of_life_date      = "%s"
+  eula                  = "https://eula.net"
+  privacy_statement_uri = "https://privacy.statement.net"
+  release_note_uri      = "https://release.note.net"
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger, time.Now().Add(time.Hour*24*365).UTC().Format(time.RFC3339))
+}
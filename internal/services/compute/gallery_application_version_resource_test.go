@@ -0,0 +1,271 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type GalleryApplicationVersionResource struct{}
+
+func TestAccGalleryApplicationVersion_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_gallery_application_version", "test")
+	r := GalleryApplicationVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_region.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGalleryApplicationVersion_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_gallery_application_version", "test")
+	r := GalleryApplicationVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccGalleryApplicationVersion_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_gallery_application_version", "test")
+	r := GalleryApplicationVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGalleryApplicationVersion_targetRegionUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_gallery_application_version", "test")
+	r := GalleryApplicationVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_region.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.targetRegionUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_region.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_region.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (GalleryApplicationVersionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.GalleryApplicationVersionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Compute.GalleryApplicationVersionsClient.Get(ctx, id.ResourceGroup, id.GalleryName, id.ApplicationName, id.VersionName, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (GalleryApplicationVersionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_gallery_application" "test" {
+  name              = "acctest-app-%d"
+  gallery_id        = azurerm_shared_image_gallery.test.id
+  location          = azurerm_resource_group.test.location
+  supported_os_type = "Linux"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "test"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "blob"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "scripts"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "[scripts file content]"
+}
+`, GalleryApplicationResource{}.template(data), data.RandomInteger, data.RandomString)
+}
+
+func (r GalleryApplicationVersionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_gallery_application_version" "test" {
+  name                   = "0.0.1"
+  gallery_application_id = azurerm_gallery_application.test.id
+  location               = azurerm_gallery_application.test.location
+
+  manage_action {
+    install = "[install command]"
+    remove  = "[remove command]"
+  }
+
+  source {
+    media_link = azurerm_storage_blob.test.id
+  }
+
+  target_region {
+    name                   = azurerm_gallery_application.test.location
+    regional_replica_count = 1
+  }
+}
+`, r.template(data))
+}
+
+func (r GalleryApplicationVersionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_gallery_application_version" "import" {
+  name                   = azurerm_gallery_application_version.test.name
+  gallery_application_id = azurerm_gallery_application_version.test.gallery_application_id
+  location               = azurerm_gallery_application_version.test.location
+
+  manage_action {
+    install = "[install command]"
+    remove  = "[remove command]"
+  }
+
+  source {
+    media_link = azurerm_storage_blob.test.id
+  }
+
+  target_region {
+    name                   = azurerm_gallery_application.test.location
+    regional_replica_count = 1
+  }
+}
+`, r.basic(data))
+}
+
+func (r GalleryApplicationVersionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob" "config" {
+  name                   = "config"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "[config file content]"
+}
+
+resource "azurerm_gallery_application_version" "test" {
+  name                   = "0.0.1"
+  gallery_application_id = azurerm_gallery_application.test.id
+  location               = azurerm_gallery_application.test.location
+  enable_health_check    = true
+  end_of_life_date       = "%s"
+  exclude_from_latest    = true
+
+  manage_action {
+    install = "[install command]"
+    remove  = "[remove command]"
+    update  = "[update command]"
+  }
+
+  source {
+    media_link                 = azurerm_storage_blob.test.id
+    default_configuration_link = azurerm_storage_blob.config.id
+  }
+
+  target_region {
+    name                   = azurerm_gallery_application.test.location
+    regional_replica_count = 2
+    storage_account_type   = "Premium_LRS"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), time.Now().Add(time.Hour*24*365).UTC().Format(time.RFC3339))
+}
+
+func (r GalleryApplicationVersionResource) targetRegionUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_gallery_application_version" "test" {
+  name                   = "0.0.1"
+  gallery_application_id = azurerm_gallery_application.test.id
+  location               = azurerm_gallery_application.test.location
+
+  manage_action {
+    install = "[install command]"
+    remove  = "[remove command]"
+  }
+
+  source {
+    media_link = azurerm_storage_blob.test.id
+  }
+
+  target_region {
+    name                   = azurerm_gallery_application.test.location
+    regional_replica_count = 1
+  }
+
+  target_region {
+    name                   = "%s"
+    regional_replica_count = 2
+  }
+}
+`, r.template(data), data.Locations.Secondary)
+}
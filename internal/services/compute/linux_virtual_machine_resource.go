@@ -120,6 +120,16 @@ func resourceLinuxVirtualMachine() *pluginsdk.Resource {
 
 			"boot_diagnostics": bootDiagnosticsSchema(),
 
+			"capacity_reservation_group_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: computeValidate.CapacityReservationGroupID,
+				ConflictsWith: []string{
+					"availability_set_id",
+					"proximity_placement_group_id",
+				},
+			},
+
 			"computer_name": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -173,6 +183,8 @@ func resourceLinuxVirtualMachine() *pluginsdk.Resource {
 				ValidateFunc: azValidate.ISO8601DurationBetween("PT15M", "PT2H"),
 			},
 
+			"gallery_application": galleryApplicationSchema(false),
+
 			"identity": virtualMachineIdentity{}.Schema(),
 
 			"license_type": {
@@ -193,6 +205,8 @@ func resourceLinuxVirtualMachine() *pluginsdk.Resource {
 
 			"plan": planSchema(),
 
+			"power_state": virtualMachinePowerStateSchema(),
+
 			"priority": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -318,6 +332,8 @@ func resourceLinuxVirtualMachine() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(virtualMachineEvictedSpotInstanceCustomizeDiff),
 	}
 }
 
@@ -448,6 +464,12 @@ func resourceLinuxVirtualMachineCreate(d *pluginsdk.ResourceData, meta interface
 		params.VirtualMachineProperties.LicenseType = utils.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("gallery_application"); ok {
+		params.VirtualMachineProperties.ApplicationProfile = &compute.ApplicationProfile{
+			GalleryApplications: expandGalleryApplications(v.([]interface{})),
+		}
+	}
+
 	if encryptionAtHostEnabled, ok := d.GetOk("encryption_at_host_enabled"); ok {
 		params.VirtualMachineProperties.SecurityProfile = &compute.SecurityProfile{
 			EncryptionAtHost: utils.Bool(encryptionAtHostEnabled.(bool)),
@@ -522,6 +544,14 @@ func resourceLinuxVirtualMachineCreate(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
+	if v, ok := d.GetOk("capacity_reservation_group_id"); ok {
+		params.CapacityReservation = &compute.CapacityReservationProfile{
+			CapacityReservationGroup: &compute.SubResource{
+				ID: utils.String(v.(string)),
+			},
+		}
+	}
+
 	if v, ok := d.GetOk("virtual_machine_scale_set_id"); ok {
 		params.VirtualMachineScaleSet = &compute.SubResource{
 			ID: utils.String(v.(string)),
@@ -570,6 +600,14 @@ func resourceLinuxVirtualMachineCreate(d *pluginsdk.ResourceData, meta interface
 	}
 
 	d.SetId(*read.ID)
+
+	// Virtual Machines are running once they've been provisioned
+	if powerState := d.Get("power_state").(string); powerState != "" && powerState != virtualMachinePowerStateRunning {
+		if err := updateVirtualMachinePowerState(ctx, client, resourceGroup, name, powerState, meta.(*clients.Client).Features.VirtualMachine.GracefulShutdown); err != nil {
+			return err
+		}
+	}
+
 	return resourceLinuxVirtualMachineRead(d, meta)
 }
 
@@ -640,6 +678,10 @@ func resourceLinuxVirtualMachineRead(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("setting `boot_diagnostics`: %+v", err)
 	}
 
+	if err := d.Set("gallery_application", flattenGalleryApplications(props.ApplicationProfile)); err != nil {
+		return fmt.Errorf("setting `gallery_application`: %+v", err)
+	}
+
 	d.Set("eviction_policy", string(props.EvictionPolicy))
 	if profile := props.HardwareProfile; profile != nil {
 		d.Set("size", string(profile.VMSize))
@@ -670,6 +712,12 @@ func resourceLinuxVirtualMachineRead(d *pluginsdk.ResourceData, meta interface{}
 	}
 	d.Set("dedicated_host_id", dedicatedHostId)
 
+	capacityReservationGroupId := ""
+	if props.CapacityReservation != nil && props.CapacityReservation.CapacityReservationGroup != nil && props.CapacityReservation.CapacityReservationGroup.ID != nil {
+		capacityReservationGroupId = *props.CapacityReservation.CapacityReservationGroup.ID
+	}
+	d.Set("capacity_reservation_group_id", capacityReservationGroupId)
+
 	virtualMachineScaleSetId := ""
 	if props.VirtualMachineScaleSet != nil && props.VirtualMachineScaleSet.ID != nil {
 		virtualMachineScaleSetId = *props.VirtualMachineScaleSet.ID
@@ -766,6 +814,12 @@ func resourceLinuxVirtualMachineRead(d *pluginsdk.ResourceData, meta interface{}
 
 	d.Set("virtual_machine_id", props.VMID)
 
+	instanceView, err := client.InstanceView(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving InstanceView for Linux Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	d.Set("power_state", virtualMachinePowerState(instanceView))
+
 	zone := ""
 	if resp.Zones != nil {
 		if zones := *resp.Zones; len(zones) > 0 {
@@ -841,6 +895,15 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 		update.VirtualMachineProperties.DiagnosticsProfile = expandBootDiagnostics(bootDiagnosticsRaw)
 	}
 
+	if d.HasChange("gallery_application") {
+		shouldUpdate = true
+
+		// an empty list is sent to remove all of the Gallery Applications from the Virtual Machine
+		update.VirtualMachineProperties.ApplicationProfile = &compute.ApplicationProfile{
+			GalleryApplications: expandGalleryApplications(d.Get("gallery_application").([]interface{})),
+		}
+	}
+
 	if d.HasChange("secret") {
 		shouldUpdate = true
 
@@ -958,6 +1021,27 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
+	if d.HasChange("capacity_reservation_group_id") {
+		shouldUpdate = true
+
+		// the Capacity Reservation Group can only be changed whilst the Virtual Machine is deallocated
+		shouldShutDown = true
+		shouldDeallocate = true
+
+		if v, ok := d.GetOk("capacity_reservation_group_id"); ok {
+			update.VirtualMachineProperties.CapacityReservation = &compute.CapacityReservationProfile{
+				CapacityReservationGroup: &compute.SubResource{
+					ID: utils.String(v.(string)),
+				},
+			}
+		} else {
+			// an empty Capacity Reservation Group dissociates the Virtual Machine from the Capacity Reservation Group
+			update.VirtualMachineProperties.CapacityReservation = &compute.CapacityReservationProfile{
+				CapacityReservationGroup: &compute.SubResource{},
+			}
+		}
+	}
+
 	if d.HasChange("size") {
 		shouldUpdate = true
 
@@ -1205,8 +1289,8 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 		log.Printf("[DEBUG] Updated Linux Virtual Machine %q (Resource Group %q).", id.Name, id.ResourceGroup)
 	}
 
-	// if we've shut it down and it was turned off, let's boot it back up
-	if shouldTurnBackOn && shouldShutDown {
+	// if we've shut it down and it was turned off, let's boot it back up - unless the `power_state` is changing
+	if shouldTurnBackOn && shouldShutDown && !d.HasChange("power_state") {
 		log.Printf("[DEBUG] Starting Linux Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
 		future, err := client.Start(ctx, id.ResourceGroup, id.Name)
 		if err != nil {
@@ -1220,6 +1304,13 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 		log.Printf("[DEBUG] Started Linux Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
 	}
 
+	// the `power_state` has either been changed in the config or by `virtualMachineEvictedSpotInstanceCustomizeDiff`
+	if d.HasChange("power_state") {
+		if err := updateVirtualMachinePowerState(ctx, client, id.ResourceGroup, id.Name, d.Get("power_state").(string), meta.(*clients.Client).Features.VirtualMachine.GracefulShutdown); err != nil {
+			return err
+		}
+	}
+
 	return resourceLinuxVirtualMachineRead(d, meta)
 }
 
@@ -484,7 +484,6 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	storageAccountType := d.Get("storage_account_type").(string)
 	diskSizeGB := d.Get("disk_size_gb").(int)
 	onDemandBurstingEnabled := d.Get("on_demand_bursting_enabled").(bool)
-	shouldShutDown := false
 
 	disk, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -499,6 +498,12 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		DiskUpdateProperties: &compute.DiskUpdateProperties{},
 	}
 
+	changes := managedDiskUpdateChanges{
+		Attached:           disk.ManagedBy != nil,
+		StorageAccountType: storageAccountType,
+		MaxShares:          maxShares,
+	}
+
 	if d.HasChange("max_shares") {
 		diskUpdate.MaxShares = utils.Int32(int32(maxShares))
 		var skuName compute.DiskStorageAccountTypes
@@ -516,7 +521,6 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		if storageAccountType != string(compute.StorageAccountTypesPremiumZRS) && storageAccountType != string(compute.StorageAccountTypesPremiumLRS) {
 			return fmt.Errorf("`tier` can only be specified when `storage_account_type` is set to `Premium_LRS` or `Premium_ZRS`")
 		}
-		shouldShutDown = true
 		tier := d.Get("tier").(string)
 		diskUpdate.Tier = &tier
 	}
@@ -527,7 +531,7 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	}
 
 	if d.HasChange("storage_account_type") {
-		shouldShutDown = true
+		changes.StorageAccountTypeChanged = true
 		var skuName compute.DiskStorageAccountTypes
 		for _, v := range compute.PossibleDiskStorageAccountTypesValues() {
 			if strings.EqualFold(storageAccountType, string(v)) {
@@ -580,7 +584,9 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	if d.HasChange("disk_size_gb") {
 		if old, new := d.GetChange("disk_size_gb"); new.(int) > old.(int) {
-			shouldShutDown = true
+			changes.Expanding = true
+			changes.OldSizeGB = old.(int)
+			changes.NewSizeGB = new.(int)
 			diskUpdate.DiskUpdateProperties.DiskSizeGB = utils.Int32(int32(new.(int)))
 		} else {
 			return fmt.Errorf("- New size must be greater than original size. Shrinking disks is not supported on Azure")
@@ -588,7 +594,7 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	}
 
 	if d.HasChange("disk_encryption_set_id") {
-		shouldShutDown = true
+		changes.DiskEncryptionSetChanged = true
 		if diskEncryptionSetId := d.Get("disk_encryption_set_id").(string); diskEncryptionSetId != "" {
 			encryptionType, err := retrieveDiskEncryptionSetEncryptionType(ctx, meta.(*clients.Client).Compute.DiskEncryptionSetsClient, diskEncryptionSetId)
 			if err != nil {
@@ -643,15 +649,22 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	}
 
 	if d.HasChange("on_demand_bursting_enabled") {
-		shouldShutDown = true
+		changes.EnablingOnDemandBursting = onDemandBurstingEnabled
 		diskUpdate.BurstingEnabled = utils.Bool(onDemandBurstingEnabled)
 	}
 
-	// whilst we need to shut this down, if we're not attached to anything there's no point
-	if shouldShutDown && disk.ManagedBy == nil {
-		shouldShutDown = false
+	// only the OS Disk can't be expanded online, so we only need to look this up when the disk is being expanded
+	if changes.Attached && changes.Expanding {
+		attachedAsOSDisk, err := managedDiskIsAttachedAsOSDisk(ctx, meta.(*clients.Client).Compute.VMClient, *disk.ManagedBy, d.Id())
+		if err != nil {
+			return err
+		}
+		changes.AttachedAsOSDisk = attachedAsOSDisk
 	}
 
+	shouldShutDown := changes.requiresShutdown()
+	log.Printf("[DEBUG] Managed Disk %q (Resource Group %q) attached: %t, requires shutdown of the Virtual Machine: %t", name, resourceGroup, changes.Attached, shouldShutDown)
+
 	// if we are attached to a VM we bring down the VM as necessary for the operations which are not allowed while it's online
 	if shouldShutDown {
 		virtualMachine, err := parse.VirtualMachineID(*disk.ManagedBy)
@@ -0,0 +1,137 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+)
+
+// disks which are 4TiB or smaller can't be expanded beyond 4TiB whilst they're attached to a running Virtual Machine
+const managedDiskOnlineExpansionThresholdGB = 4096
+
+type managedDiskUpdateChanges struct {
+	// is the Managed Disk attached to a Virtual Machine?
+	Attached bool
+
+	// is the Managed Disk attached to a Virtual Machine as the OS Disk?
+	AttachedAsOSDisk bool
+
+	// the `storage_account_type` of the Managed Disk
+	StorageAccountType string
+
+	// the `max_shares` of the Managed Disk, where a value greater than 1 means this is a Shared Disk
+	MaxShares int
+
+	// are we changing the `storage_account_type`?
+	StorageAccountTypeChanged bool
+
+	// are we changing the `disk_encryption_set_id`?
+	DiskEncryptionSetChanged bool
+
+	// are we enabling on-demand bursting?
+	EnablingOnDemandBursting bool
+
+	// are we expanding the disk - and if so from/to what size?
+	Expanding bool
+	OldSizeGB int
+	NewSizeGB int
+}
+
+// requiresShutdown determines whether the Virtual Machine the Managed Disk is attached to needs to be
+// shut down (and deallocated) for these changes to be applied, or whether they can be applied online
+func (c managedDiskUpdateChanges) requiresShutdown() bool {
+	// when the disk isn't attached to anything there's no point
+	if !c.Attached {
+		return false
+	}
+
+	// changing the SKU or the encryption settings always requires the Virtual Machine to be deallocated
+	if c.StorageAccountTypeChanged || c.DiskEncryptionSetChanged {
+		return true
+	}
+
+	// on-demand bursting can only be enabled on a disk which is unattached or attached to a deallocated Virtual
+	// Machine - however it can be disabled online
+	if c.EnablingOnDemandBursting {
+		return true
+	}
+
+	// the performance tier can be changed whilst the Virtual Machine is running, as such this isn't considered here
+
+	if c.Expanding && !managedDiskCanBeExpandedOnline(c.StorageAccountType, c.AttachedAsOSDisk, c.MaxShares, c.OldSizeGB, c.NewSizeGB) {
+		return true
+	}
+
+	return false
+}
+
+// managedDiskCanBeExpandedOnline determines whether the Managed Disk can be expanded whilst it's attached to a
+// running Virtual Machine: https://docs.microsoft.com/en-us/azure/virtual-machines/linux/expand-disks#expand-without-downtime
+func managedDiskCanBeExpandedOnline(storageAccountType string, attachedAsOSDisk bool, maxShares int, oldSizeGB int, newSizeGB int) bool {
+	// only Data Disks can be expanded online
+	if attachedAsOSDisk {
+		return false
+	}
+
+	// Shared Disks can't be expanded online
+	if maxShares > 1 {
+		return false
+	}
+
+	supportedStorageAccountTypes := []compute.DiskStorageAccountTypes{
+		compute.DiskStorageAccountTypesPremiumLRS,
+		compute.DiskStorageAccountTypesPremiumZRS,
+		compute.DiskStorageAccountTypesStandardLRS,
+		compute.DiskStorageAccountTypesStandardSSDLRS,
+		compute.DiskStorageAccountTypesStandardSSDZRS,
+	}
+	supported := false
+	for _, v := range supportedStorageAccountTypes {
+		if strings.EqualFold(storageAccountType, string(v)) {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return false
+	}
+
+	if oldSizeGB <= managedDiskOnlineExpansionThresholdGB && newSizeGB > managedDiskOnlineExpansionThresholdGB {
+		return false
+	}
+
+	return true
+}
+
+// managedDiskIsAttachedAsOSDisk determines whether the Managed Disk is the OS Disk of the Virtual Machine
+// which it's attached to
+func managedDiskIsAttachedAsOSDisk(ctx context.Context, client *compute.VirtualMachinesClient, virtualMachineId string, diskId string) (bool, error) {
+	id, err := parse.VirtualMachineID(virtualMachineId)
+	if err != nil {
+		return false, fmt.Errorf("parsing VMID %q for disk attachment: %+v", virtualMachineId, err)
+	}
+
+	virtualMachine, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return false, fmt.Errorf("retrieving Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return virtualMachineHasOSDisk(virtualMachine, diskId), nil
+}
+
+func virtualMachineHasOSDisk(virtualMachine compute.VirtualMachine, diskId string) bool {
+	props := virtualMachine.VirtualMachineProperties
+	if props == nil || props.StorageProfile == nil || props.StorageProfile.OsDisk == nil {
+		return false
+	}
+
+	osDisk := props.StorageProfile.OsDisk
+	if osDisk.ManagedDisk == nil || osDisk.ManagedDisk.ID == nil {
+		return false
+	}
+
+	return strings.EqualFold(*osDisk.ManagedDisk.ID, diskId)
+}
@@ -0,0 +1,323 @@
+package compute
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestManagedDiskUpdateRequiresShutdown(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    managedDiskUpdateChanges
+		Expected bool
+	}{
+		{
+			Name: "Unattached Storage Account Type Change",
+			Input: managedDiskUpdateChanges{
+				Attached:                  false,
+				StorageAccountType:        "Premium_LRS",
+				StorageAccountTypeChanged: true,
+			},
+			Expected: false,
+		},
+		{
+			Name: "Unattached OS Disk Expansion",
+			Input: managedDiskUpdateChanges{
+				Attached:           false,
+				StorageAccountType: "Premium_LRS",
+				Expanding:          true,
+				OldSizeGB:          30,
+				NewSizeGB:          64,
+			},
+			Expected: false,
+		},
+		{
+			Name: "Attached Tags Only",
+			Input: managedDiskUpdateChanges{
+				Attached:           true,
+				StorageAccountType: "Premium_LRS",
+			},
+			Expected: false,
+		},
+		{
+			Name: "Attached Storage Account Type Change",
+			Input: managedDiskUpdateChanges{
+				Attached:                  true,
+				StorageAccountType:        "Premium_LRS",
+				StorageAccountTypeChanged: true,
+			},
+			Expected: true,
+		},
+		{
+			Name: "Attached Disk Encryption Set Change",
+			Input: managedDiskUpdateChanges{
+				Attached:                 true,
+				StorageAccountType:       "Premium_LRS",
+				DiskEncryptionSetChanged: true,
+			},
+			Expected: true,
+		},
+		{
+			Name: "Unattached Enabling On Demand Bursting",
+			Input: managedDiskUpdateChanges{
+				Attached:                 false,
+				StorageAccountType:       "Premium_LRS",
+				EnablingOnDemandBursting: true,
+			},
+			Expected: false,
+		},
+		{
+			Name: "Attached Enabling On Demand Bursting",
+			Input: managedDiskUpdateChanges{
+				Attached:                 true,
+				StorageAccountType:       "Premium_LRS",
+				EnablingOnDemandBursting: true,
+			},
+			Expected: true,
+		},
+		{
+			Name: "Attached Data Disk Expansion and Enabling On Demand Bursting",
+			Input: managedDiskUpdateChanges{
+				Attached:                 true,
+				StorageAccountType:       "Premium_LRS",
+				EnablingOnDemandBursting: true,
+				Expanding:                true,
+				OldSizeGB:                512,
+				NewSizeGB:                1024,
+			},
+			Expected: true,
+		},
+		{
+			Name: "Attached Data Disk Expansion",
+			Input: managedDiskUpdateChanges{
+				Attached:           true,
+				StorageAccountType: "StandardSSD_LRS",
+				Expanding:          true,
+				OldSizeGB:          128,
+				NewSizeGB:          256,
+			},
+			Expected: false,
+		},
+		{
+			Name: "Attached OS Disk Expansion",
+			Input: managedDiskUpdateChanges{
+				Attached:           true,
+				AttachedAsOSDisk:   true,
+				StorageAccountType: "StandardSSD_LRS",
+				Expanding:          true,
+				OldSizeGB:          30,
+				NewSizeGB:          64,
+			},
+			Expected: true,
+		},
+		{
+			Name: "Attached Premium Data Disk Expansion",
+			Input: managedDiskUpdateChanges{
+				Attached:           true,
+				StorageAccountType: "Premium_LRS",
+				Expanding:          true,
+				OldSizeGB:          128,
+				NewSizeGB:          256,
+			},
+			Expected: false,
+		},
+		{
+			Name: "Attached Data Disk Expansion and Storage Account Type Change",
+			Input: managedDiskUpdateChanges{
+				Attached:                  true,
+				StorageAccountType:        "Premium_LRS",
+				StorageAccountTypeChanged: true,
+				Expanding:                 true,
+				OldSizeGB:                 128,
+				NewSizeGB:                 256,
+			},
+			Expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("[DEBUG] Testing %q..", testCase.Name)
+
+		actual := testCase.Input.requiresShutdown()
+		if actual != testCase.Expected {
+			t.Fatalf("Expected %t but got %t", testCase.Expected, actual)
+		}
+	}
+}
+
+func TestManagedDiskCanBeExpandedOnline(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		StorageAccountType string
+		AttachedAsOSDisk   bool
+		MaxShares          int
+		OldSizeGB          int
+		NewSizeGB          int
+		Expected           bool
+	}{
+		{
+			Name:               "Standard HDD",
+			StorageAccountType: "Standard_LRS",
+			OldSizeGB:          32,
+			NewSizeGB:          64,
+			Expected:           true,
+		},
+		{
+			Name:               "Standard SSD",
+			StorageAccountType: "StandardSSD_ZRS",
+			OldSizeGB:          32,
+			NewSizeGB:          64,
+			Expected:           true,
+		},
+		{
+			Name:               "Premium SSD",
+			StorageAccountType: "Premium_LRS",
+			OldSizeGB:          32,
+			NewSizeGB:          64,
+			Expected:           true,
+		},
+		{
+			Name:               "Premium SSD Casing",
+			StorageAccountType: "premium_lrs",
+			OldSizeGB:          32,
+			NewSizeGB:          64,
+			Expected:           true,
+		},
+		{
+			Name:               "Ultra SSD",
+			StorageAccountType: "UltraSSD_LRS",
+			OldSizeGB:          32,
+			NewSizeGB:          64,
+			Expected:           false,
+		},
+		{
+			Name:               "OS Disk",
+			StorageAccountType: "Premium_LRS",
+			AttachedAsOSDisk:   true,
+			OldSizeGB:          32,
+			NewSizeGB:          64,
+			Expected:           false,
+		},
+		{
+			Name:               "Shared Disk",
+			StorageAccountType: "Premium_LRS",
+			MaxShares:          2,
+			OldSizeGB:          256,
+			NewSizeGB:          512,
+			Expected:           false,
+		},
+		{
+			Name:               "Single Share",
+			StorageAccountType: "Premium_LRS",
+			MaxShares:          1,
+			OldSizeGB:          256,
+			NewSizeGB:          512,
+			Expected:           true,
+		},
+		{
+			Name:               "Up To 4TiB",
+			StorageAccountType: "Premium_LRS",
+			OldSizeGB:          1024,
+			NewSizeGB:          4096,
+			Expected:           true,
+		},
+		{
+			Name:               "Crossing 4TiB",
+			StorageAccountType: "Premium_LRS",
+			OldSizeGB:          1024,
+			NewSizeGB:          4097,
+			Expected:           false,
+		},
+		{
+			Name:               "From 4TiB",
+			StorageAccountType: "Premium_LRS",
+			OldSizeGB:          4096,
+			NewSizeGB:          8192,
+			Expected:           false,
+		},
+		{
+			Name:               "Above 4TiB",
+			StorageAccountType: "Premium_LRS",
+			OldSizeGB:          8192,
+			NewSizeGB:          16384,
+			Expected:           true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("[DEBUG] Testing %q..", testCase.Name)
+
+		actual := managedDiskCanBeExpandedOnline(testCase.StorageAccountType, testCase.AttachedAsOSDisk, testCase.MaxShares, testCase.OldSizeGB, testCase.NewSizeGB)
+		if actual != testCase.Expected {
+			t.Fatalf("Expected %t but got %t", testCase.Expected, actual)
+		}
+	}
+}
+
+func TestVirtualMachineHasOSDisk(t *testing.T) {
+	diskId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.Compute/disks/disk1"
+
+	testCases := []struct {
+		Name     string
+		Input    compute.VirtualMachine
+		Expected bool
+	}{
+		{
+			Name:     "No Properties",
+			Input:    compute.VirtualMachine{},
+			Expected: false,
+		},
+		{
+			Name: "Unmanaged OS Disk",
+			Input: compute.VirtualMachine{
+				VirtualMachineProperties: &compute.VirtualMachineProperties{
+					StorageProfile: &compute.StorageProfile{
+						OsDisk: &compute.OSDisk{},
+					},
+				},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Different OS Disk",
+			Input: compute.VirtualMachine{
+				VirtualMachineProperties: &compute.VirtualMachineProperties{
+					StorageProfile: &compute.StorageProfile{
+						OsDisk: &compute.OSDisk{
+							ManagedDisk: &compute.ManagedDiskParameters{
+								ID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.Compute/disks/disk2"),
+							},
+						},
+					},
+				},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Same OS Disk Different Casing",
+			Input: compute.VirtualMachine{
+				VirtualMachineProperties: &compute.VirtualMachineProperties{
+					StorageProfile: &compute.StorageProfile{
+						OsDisk: &compute.OSDisk{
+							ManagedDisk: &compute.ManagedDiskParameters{
+								ID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RESGROUP1/providers/Microsoft.Compute/disks/disk1"),
+							},
+						},
+					},
+				},
+			},
+			Expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("[DEBUG] Testing %q..", testCase.Name)
+
+		actual := virtualMachineHasOSDisk(testCase.Input, diskId)
+		if actual != testCase.Expected {
+			t.Fatalf("Expected %t but got %t", testCase.Expected, actual)
+		}
+	}
+}
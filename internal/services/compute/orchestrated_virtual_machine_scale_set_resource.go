@@ -80,10 +80,23 @@ func resourceOrchestratedVirtualMachineScaleSet() *pluginsdk.Resource {
 			"os_profile": OrchestratedVirtualMachineScaleSetOSProfileSchema(),
 
 			// Optional
+			"additional_capabilities": VirtualMachineScaleSetAdditionalCapabilitiesSchema(),
+
 			"automatic_instance_repair": OrchestratedVirtualMachineScaleSetAutomaticRepairsPolicySchema(),
 
 			"boot_diagnostics": bootDiagnosticsSchema(),
 
+			// the Virtual Machine Scale Set Update API doesn't support updating the Capacity Reservation Profile
+			"capacity_reservation_group_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: computeValidate.CapacityReservationGroupID,
+				ConflictsWith: []string{
+					"proximity_placement_group_id",
+				},
+			},
+
 			"data_disk": OrchestratedVirtualMachineScaleSetDataDiskSchema(),
 
 			"encryption_at_host_enabled": {
@@ -166,6 +179,12 @@ func resourceOrchestratedVirtualMachineScaleSet() *pluginsdk.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
+			"secure_boot_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
 			// removing single_placement_group since it has been retired as of version 2019-12-01 for Flex VMSS
 			"source_image_id": {
 				Type:         pluginsdk.TypeString,
@@ -175,6 +194,12 @@ func resourceOrchestratedVirtualMachineScaleSet() *pluginsdk.Resource {
 
 			"source_image_reference": sourceImageReferenceSchema(false),
 
+			"vtpm_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
 			"zone_balance": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -343,7 +368,7 @@ func resourceOrchestratedVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData,
 	}
 
 	if v, ok := d.GetOk("data_disk"); ok {
-		ultraSSDEnabled := false // Currently not supported in orchestrated VMSS
+		ultraSSDEnabled := d.Get("additional_capabilities.0.ultra_ssd_enabled").(bool)
 		dataDisks, err := ExpandVirtualMachineScaleSetDataDisk(v.([]interface{}), ultraSSDEnabled)
 		if err != nil {
 			return fmt.Errorf("expanding `data_disk`: %+v", err)
@@ -399,6 +424,36 @@ func resourceOrchestratedVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData,
 		}
 	}
 
+	if secureBootEnabled, ok := d.GetOk("secure_boot_enabled"); ok && secureBootEnabled.(bool) {
+		if virtualMachineProfile.SecurityProfile == nil {
+			virtualMachineProfile.SecurityProfile = &compute.SecurityProfile{}
+		}
+		if virtualMachineProfile.SecurityProfile.UefiSettings == nil {
+			virtualMachineProfile.SecurityProfile.UefiSettings = &compute.UefiSettings{}
+		}
+		virtualMachineProfile.SecurityProfile.SecurityType = compute.SecurityTypesTrustedLaunch
+		virtualMachineProfile.SecurityProfile.UefiSettings.SecureBootEnabled = utils.Bool(secureBootEnabled.(bool))
+	}
+
+	if vtpmEnabled, ok := d.GetOk("vtpm_enabled"); ok && vtpmEnabled.(bool) {
+		if virtualMachineProfile.SecurityProfile == nil {
+			virtualMachineProfile.SecurityProfile = &compute.SecurityProfile{}
+		}
+		if virtualMachineProfile.SecurityProfile.UefiSettings == nil {
+			virtualMachineProfile.SecurityProfile.UefiSettings = &compute.UefiSettings{}
+		}
+		virtualMachineProfile.SecurityProfile.SecurityType = compute.SecurityTypesTrustedLaunch
+		virtualMachineProfile.SecurityProfile.UefiSettings.VTpmEnabled = utils.Bool(vtpmEnabled.(bool))
+	}
+
+	if v, ok := d.GetOk("capacity_reservation_group_id"); ok {
+		virtualMachineProfile.CapacityReservation = &compute.CapacityReservationProfile{
+			CapacityReservationGroup: &compute.SubResource{
+				ID: utils.String(v.(string)),
+			},
+		}
+	}
+
 	if v, ok := d.GetOk("eviction_policy"); ok {
 		if virtualMachineProfile.Priority != compute.VirtualMachinePriorityTypesSpot {
 			return fmt.Errorf("an `eviction_policy` can only be specified when `priority` is set to `Spot`")
@@ -430,6 +485,10 @@ func resourceOrchestratedVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData,
 			props.Identity = identity
 		}
 
+		if v, ok := d.GetOk("additional_capabilities"); ok {
+			props.VirtualMachineScaleSetProperties.AdditionalCapabilities = ExpandVirtualMachineScaleSetAdditionalCapabilities(v.([]interface{}))
+		}
+
 		if v, ok := d.GetOk("automatic_instance_repair"); ok {
 			props.VirtualMachineScaleSetProperties.AutomaticRepairsPolicy = ExpandOrchestratedVirtualMachineScaleSetAutomaticRepairsPolicy(v.([]interface{}))
 		}
@@ -663,7 +722,7 @@ func resourceOrchestratedVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData,
 			}
 
 			if d.HasChange("data_disk") {
-				ultraSSDEnabled := false // Currently not supported in orchestrated vmss
+				ultraSSDEnabled := d.Get("additional_capabilities.0.ultra_ssd_enabled").(bool)
 				dataDisks, err := ExpandOrchestratedVirtualMachineScaleSetDataDisk(d.Get("data_disk").([]interface{}), ultraSSDEnabled)
 				if err != nil {
 					return fmt.Errorf("expanding `data_disk`: %+v", err)
@@ -725,9 +784,13 @@ func resourceOrchestratedVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData,
 		}
 
 		if d.HasChange("encryption_at_host_enabled") {
-			updateProps.VirtualMachineProfile.SecurityProfile = &compute.SecurityProfile{
-				EncryptionAtHost: utils.Bool(d.Get("encryption_at_host_enabled").(bool)),
+			// the Security Profile is replaced as a whole, so any Trusted Launch settings need to be threaded through
+			securityProfile := compute.SecurityProfile{}
+			if existing.VirtualMachineScaleSetProperties.VirtualMachineProfile.SecurityProfile != nil {
+				securityProfile = *existing.VirtualMachineScaleSetProperties.VirtualMachineProfile.SecurityProfile
 			}
+			securityProfile.EncryptionAtHost = utils.Bool(d.Get("encryption_at_host_enabled").(bool))
+			updateProps.VirtualMachineProfile.SecurityProfile = &securityProfile
 		}
 
 		if d.HasChange("license_type") {
@@ -895,6 +958,10 @@ func resourceOrchestratedVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, m
 	}
 	props := *resp.VirtualMachineScaleSetProperties
 
+	if err := d.Set("additional_capabilities", FlattenVirtualMachineScaleSetAdditionalCapabilities(props.AdditionalCapabilities)); err != nil {
+		return fmt.Errorf("setting `additional_capabilities`: %+v", err)
+	}
+
 	if err := d.Set("automatic_instance_repair", FlattenOrchestratedVirtualMachineScaleSetAutomaticRepairsPolicy(props.AutomaticRepairsPolicy)); err != nil {
 		return fmt.Errorf("setting `automatic_instance_repair`: %+v", err)
 	}
@@ -983,10 +1050,32 @@ func resourceOrchestratedVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, m
 		d.Set("extensions_time_budget", extensionsTimeBudget)
 
 		encryptionAtHostEnabled := false
-		if profile.SecurityProfile != nil && profile.SecurityProfile.EncryptionAtHost != nil {
-			encryptionAtHostEnabled = *profile.SecurityProfile.EncryptionAtHost
+		vtpmEnabled := false
+		secureBootEnabled := false
+
+		if secprofile := profile.SecurityProfile; secprofile != nil {
+			if secprofile.EncryptionAtHost != nil {
+				encryptionAtHostEnabled = *secprofile.EncryptionAtHost
+			}
+			if uefi := secprofile.UefiSettings; uefi != nil {
+				if uefi.VTpmEnabled != nil {
+					vtpmEnabled = *uefi.VTpmEnabled
+				}
+				if uefi.SecureBootEnabled != nil {
+					secureBootEnabled = *uefi.SecureBootEnabled
+				}
+			}
 		}
+
 		d.Set("encryption_at_host_enabled", encryptionAtHostEnabled)
+		d.Set("vtpm_enabled", vtpmEnabled)
+		d.Set("secure_boot_enabled", secureBootEnabled)
+
+		capacityReservationGroupId := ""
+		if profile.CapacityReservation != nil && profile.CapacityReservation.CapacityReservationGroup != nil && profile.CapacityReservation.CapacityReservationGroup.ID != nil {
+			capacityReservationGroupId = *profile.CapacityReservation.CapacityReservationGroup.ID
+		}
+		d.Set("capacity_reservation_group_id", capacityReservationGroupId)
 	}
 
 	if err := d.Set("zones", resp.Zones); err != nil {
@@ -67,3 +67,47 @@ func ManagedDiskID(input string) (*ManagedDiskId, error) {
 
 	return &resourceId, nil
 }
+
+// ManagedDiskIDInsensitively parses an ManagedDisk ID into an ManagedDiskId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ManagedDiskID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ManagedDiskIDInsensitively(input string) (*ManagedDiskId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ManagedDiskId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'disks' segment
+	disksKey := "disks"
+	for key := range id.Path {
+		if strings.EqualFold(key, disksKey) {
+			disksKey = key
+			break
+		}
+	}
+	if resourceId.DiskName, err = id.PopSegment(disksKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
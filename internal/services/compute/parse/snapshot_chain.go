@@ -0,0 +1,82 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = SnapshotChainId{}
+
+// SnapshotChainId is a synthetic ID, since a Snapshot Chain isn't a resource within Azure - instead it's
+// the set of Snapshots within the Resource Group which are tagged with the name of the chain
+type SnapshotChainId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewSnapshotChainID(subscriptionId, resourceGroup, name string) SnapshotChainId {
+	return SnapshotChainId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id SnapshotChainId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Snapshot Chain", segmentsStr)
+}
+
+func (id SnapshotChainId) ID() string {
+	resourceGroupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", id.SubscriptionId, id.ResourceGroup)
+	return fmt.Sprintf("%s|%s", resourceGroupId, id.Name)
+}
+
+// SnapshotChainID parses a SnapshotChain ID in the format {resourceGroupID}|{snapshotChainName} into a SnapshotChainId struct
+func SnapshotChainID(input string) (*SnapshotChainId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected an ID in the format {resourceGroupID}|{snapshotChainName} but got %q", input)
+	}
+
+	id, err := resourceids.ParseAzureResourceID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Resource Group ID for Snapshot Chain %q: %+v", segments[0], err)
+	}
+
+	resourceId := SnapshotChainId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+		Name:           segments[1],
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if id.Provider != "" || len(id.Path) > 0 {
+		return nil, fmt.Errorf("expected %q to be a Resource Group ID", segments[0])
+	}
+
+	if err := id.ValidateNoEmptySegments(segments[0]); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name == "" {
+		return nil, fmt.Errorf("ID was missing the Snapshot Chain name")
+	}
+
+	return &resourceId, nil
+}
@@ -46,9 +46,13 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	resources := map[string]*pluginsdk.Resource{
 		"azurerm_availability_set":                       resourceAvailabilitySet(),
+		"azurerm_capacity_reservation":                   resourceCapacityReservation(),
+		"azurerm_capacity_reservation_group":             resourceCapacityReservationGroup(),
 		"azurerm_dedicated_host":                         resourceDedicatedHost(),
 		"azurerm_dedicated_host_group":                   resourceDedicatedHostGroup(),
 		"azurerm_disk_encryption_set":                    resourceDiskEncryptionSet(),
+		"azurerm_gallery_application":                    resourceGalleryApplication(),
+		"azurerm_gallery_application_version":            resourceGalleryApplicationVersion(),
 		"azurerm_image":                                  resourceImage(),
 		"azurerm_managed_disk":                           resourceManagedDisk(),
 		"azurerm_disk_access":                            resourceDiskAccess(),
@@ -58,8 +62,10 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_shared_image_version":                   resourceSharedImageVersion(),
 		"azurerm_shared_image":                           resourceSharedImage(),
 		"azurerm_snapshot":                               resourceSnapshot(),
+		"azurerm_snapshot_chain":                         resourceSnapshotChain(),
 		"azurerm_virtual_machine_data_disk_attachment":   resourceVirtualMachineDataDiskAttachment(),
 		"azurerm_virtual_machine_extension":              resourceVirtualMachineExtension(),
+		"azurerm_virtual_machine_run_command":            resourceVirtualMachineRunCommand(),
 		"azurerm_virtual_machine_scale_set":              resourceVirtualMachineScaleSet(),
 		"azurerm_orchestrated_virtual_machine_scale_set": resourceOrchestratedVirtualMachineScaleSet(),
 		"azurerm_virtual_machine":                        resourceVirtualMachine(),
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -163,6 +164,111 @@ func flattenBootDiagnostics(input *compute.DiagnosticsProfile) []interface{} {
 	}
 }
 
+func galleryApplicationSchema(forceNew bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		ForceNew: forceNew,
+		MaxItems: 100,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"version_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validate.GalleryApplicationVersionID,
+				},
+
+				"configuration_blob_uri": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				},
+
+				"order": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ForceNew:     forceNew,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 2147483647),
+				},
+
+				// this is a pass-through value which isn't used by the service
+				"tag": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func expandGalleryApplications(input []interface{}) *[]compute.VMGalleryApplication {
+	applications := make([]compute.VMGalleryApplication, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		application := compute.VMGalleryApplication{
+			PackageReferenceID: utils.String(raw["version_id"].(string)),
+			Order:              utils.Int32(int32(raw["order"].(int))),
+		}
+
+		if configurationReference := raw["configuration_blob_uri"].(string); configurationReference != "" {
+			application.ConfigurationReference = utils.String(configurationReference)
+		}
+
+		if tag := raw["tag"].(string); tag != "" {
+			application.Tags = utils.String(tag)
+		}
+
+		applications = append(applications, application)
+	}
+
+	return &applications
+}
+
+func flattenGalleryApplications(input *compute.ApplicationProfile) []interface{} {
+	if input == nil || input.GalleryApplications == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, v := range *input.GalleryApplications {
+		versionId := ""
+		if v.PackageReferenceID != nil {
+			versionId = *v.PackageReferenceID
+		}
+
+		configurationReference := ""
+		if v.ConfigurationReference != nil {
+			configurationReference = *v.ConfigurationReference
+		}
+
+		order := 0
+		if v.Order != nil {
+			order = int(*v.Order)
+		}
+
+		tag := ""
+		if v.Tags != nil {
+			tag = *v.Tags
+		}
+
+		output = append(output, map[string]interface{}{
+			"version_id":             versionId,
+			"configuration_blob_uri": configurationReference,
+			"order":                  order,
+			"tag":                    tag,
+		})
+	}
+
+	return output
+}
+
 func linuxSecretSchema() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
 		Type:     pluginsdk.TypeList,
@@ -0,0 +1,90 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// listSnapshotChainSnapshots returns the Snapshots within the Snapshot Chain, ordered from oldest to newest
+func listSnapshotChainSnapshots(ctx context.Context, client *compute.SnapshotsClient, id parse.SnapshotChainId) ([]compute.Snapshot, error) {
+	snapshots := make([]compute.Snapshot, 0)
+
+	iterator, err := client.ListByResourceGroupComplete(ctx, id.ResourceGroup)
+	if err != nil {
+		return nil, fmt.Errorf("listing Snapshots within Resource Group %q: %+v", id.ResourceGroup, err)
+	}
+	for iterator.NotDone() {
+		snapshot := iterator.Value()
+		if v, ok := snapshot.Tags[snapshotChainTagName]; ok && v != nil && *v == id.Name {
+			snapshots = append(snapshots, snapshot)
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Snapshots within Resource Group %q: %+v", id.ResourceGroup, err)
+		}
+	}
+
+	sortSnapshotChainSnapshots(snapshots)
+	return snapshots, nil
+}
+
+func expandSnapshotChainTags(chainName string, input map[string]interface{}) map[string]*string {
+	output := tags.Expand(input)
+	output[snapshotChainTagName] = utils.String(chainName)
+	return output
+}
+
+func snapshotChainSnapshotName(chainName string, now time.Time) string {
+	return fmt.Sprintf("%s-%s", chainName, now.UTC().Format("20060102150405"))
+}
+
+// sortSnapshotChainSnapshots orders the Snapshots from oldest to newest, falling back to the name (which
+// contains the timestamp the Snapshot was taken at) when the creation time isn't available
+func sortSnapshotChainSnapshots(input []compute.Snapshot) {
+	sort.SliceStable(input, func(i, j int) bool {
+		first := snapshotChainSnapshotCreationTime(input[i])
+		second := snapshotChainSnapshotCreationTime(input[j])
+		if !first.Equal(second) {
+			return first.Before(second)
+		}
+
+		firstName := ""
+		if input[i].Name != nil {
+			firstName = *input[i].Name
+		}
+		secondName := ""
+		if input[j].Name != nil {
+			secondName = *input[j].Name
+		}
+		return firstName < secondName
+	})
+}
+
+func snapshotChainSnapshotCreationTime(input compute.Snapshot) time.Time {
+	if props := input.SnapshotProperties; props != nil && props.TimeCreated != nil {
+		return props.TimeCreated.ToTime()
+	}
+
+	return time.Time{}
+}
+
+// snapshotChainSnapshotsToPrune returns the oldest Snapshots which exceed the retention count, where
+// the Snapshots are ordered from oldest to newest
+func snapshotChainSnapshotsToPrune(snapshots []compute.Snapshot, retentionCount int) []compute.Snapshot {
+	if retentionCount < 0 {
+		retentionCount = 0
+	}
+
+	if len(snapshots) <= retentionCount {
+		return []compute.Snapshot{}
+	}
+
+	return snapshots[:len(snapshots)-retentionCount]
+}
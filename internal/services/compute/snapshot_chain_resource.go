@@ -0,0 +1,309 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// a Snapshot Chain isn't a resource within Azure, instead it's a set of Incremental Snapshots of a Managed Disk
+// which are tagged with the name of the chain, so that the most recent N Snapshots can be retained
+const snapshotChainTagName = "azurerm-snapshot-chain"
+
+func resourceSnapshotChain() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSnapshotChainCreate,
+		Read:   resourceSnapshotChainRead,
+		Update: resourceSnapshotChainUpdate,
+		Delete: resourceSnapshotChainDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.SnapshotChainID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.SnapshotChainName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": location.Schema(),
+
+			"managed_disk_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ManagedDiskID,
+			},
+
+			// there's a limit of 500 Incremental Snapshots per Managed Disk
+			"retention_count": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 500),
+			},
+
+			// changing any of these values takes a new Snapshot of the Managed Disk
+			"triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"tags": tags.Schema(),
+
+			"latest_snapshot_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"snapshot_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceSnapshotChainCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.SnapshotsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewSnapshotChainID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	existing, err := listSnapshotChainSnapshots(ctx, client, id)
+	if err != nil {
+		return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+	}
+	if len(existing) > 0 {
+		return tf.ImportAsExistsError("azurerm_snapshot_chain", id.ID())
+	}
+
+	managedDiskId := d.Get("managed_disk_id").(string)
+	snapshotLocation := location.Normalize(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+	if err := createSnapshotChainSnapshot(ctx, client, id, snapshotLocation, managedDiskId, t); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSnapshotChainRead(d, meta)
+}
+
+func resourceSnapshotChainRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.SnapshotsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SnapshotChainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := listSnapshotChainSnapshots(ctx, client, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if len(snapshots) == 0 {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	snapshotIds := make([]interface{}, 0)
+	for _, snapshot := range snapshots {
+		if snapshot.ID != nil {
+			snapshotIds = append(snapshotIds, *snapshot.ID)
+		}
+	}
+
+	// the most recent Snapshot is the source of truth for the chain
+	latest := snapshots[len(snapshots)-1]
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(latest.Location))
+	d.Set("snapshot_ids", snapshotIds)
+
+	latestSnapshotId := ""
+	if latest.ID != nil {
+		latestSnapshotId = *latest.ID
+	}
+	d.Set("latest_snapshot_id", latestSnapshotId)
+
+	managedDiskId := ""
+	if props := latest.SnapshotProperties; props != nil && props.CreationData != nil && props.CreationData.SourceResourceID != nil {
+		// the casing of the Source Resource ID returned by the API doesn't always match, so this is normalised
+		diskId, err := parse.ManagedDiskIDInsensitively(*props.CreationData.SourceResourceID)
+		if err != nil {
+			return err
+		}
+		managedDiskId = diskId.ID()
+	}
+	d.Set("managed_disk_id", managedDiskId)
+
+	// the tag used to identify the chain is managed by this resource, so shouldn't be exposed
+	snapshotTags := make(map[string]*string)
+	for k, v := range latest.Tags {
+		if k == snapshotChainTagName {
+			continue
+		}
+		snapshotTags[k] = v
+	}
+
+	return tags.FlattenAndSet(d, snapshotTags)
+}
+
+func resourceSnapshotChainUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.SnapshotsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SnapshotChainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	t := d.Get("tags").(map[string]interface{})
+
+	if d.HasChange("tags") {
+		snapshots, err := listSnapshotChainSnapshots(ctx, client, *id)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+
+		for _, snapshot := range snapshots {
+			if snapshot.Name == nil {
+				continue
+			}
+
+			log.Printf("[DEBUG] Updating the Tags for Snapshot %q within %s..", *snapshot.Name, *id)
+			update := compute.SnapshotUpdate{
+				Tags: expandSnapshotChainTags(id.Name, t),
+			}
+			future, err := client.Update(ctx, id.ResourceGroup, *snapshot.Name, update)
+			if err != nil {
+				return fmt.Errorf("updating Tags for Snapshot %q within %s: %+v", *snapshot.Name, *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of Tags for Snapshot %q within %s: %+v", *snapshot.Name, *id, err)
+			}
+		}
+	}
+
+	if d.HasChange("triggers") {
+		managedDiskId := d.Get("managed_disk_id").(string)
+		snapshotLocation := location.Normalize(d.Get("location").(string))
+		if err := createSnapshotChainSnapshot(ctx, client, *id, snapshotLocation, managedDiskId, t); err != nil {
+			return err
+		}
+	}
+
+	if err := pruneSnapshotChain(ctx, client, *id, d.Get("retention_count").(int)); err != nil {
+		return err
+	}
+
+	return resourceSnapshotChainRead(d, meta)
+}
+
+func resourceSnapshotChainDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.SnapshotsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SnapshotChainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := pruneSnapshotChain(ctx, client, *id, 0); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func createSnapshotChainSnapshot(ctx context.Context, client *compute.SnapshotsClient, id parse.SnapshotChainId, snapshotLocation, managedDiskId string, input map[string]interface{}) error {
+	name := snapshotChainSnapshotName(id.Name, time.Now())
+
+	snapshot := compute.Snapshot{
+		Location: utils.String(snapshotLocation),
+		SnapshotProperties: &compute.SnapshotProperties{
+			CreationData: &compute.CreationData{
+				CreateOption:     compute.DiskCreateOptionCopy,
+				SourceResourceID: utils.String(managedDiskId),
+			},
+			Incremental: utils.Bool(true),
+		},
+		Tags: expandSnapshotChainTags(id.Name, input),
+	}
+
+	log.Printf("[DEBUG] Creating Snapshot %q within %s..", name, id)
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, name, snapshot)
+	if err != nil {
+		return fmt.Errorf("creating Snapshot %q within %s: %+v", name, id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of Snapshot %q within %s: %+v", name, id, err)
+	}
+
+	return nil
+}
+
+func pruneSnapshotChain(ctx context.Context, client *compute.SnapshotsClient, id parse.SnapshotChainId, retentionCount int) error {
+	snapshots, err := listSnapshotChainSnapshots(ctx, client, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	for _, snapshot := range snapshotChainSnapshotsToPrune(snapshots, retentionCount) {
+		if snapshot.Name == nil {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting Snapshot %q within %s..", *snapshot.Name, id)
+		future, err := client.Delete(ctx, id.ResourceGroup, *snapshot.Name)
+		if err != nil {
+			return fmt.Errorf("deleting Snapshot %q within %s: %+v", *snapshot.Name, id, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for deletion of Snapshot %q within %s: %+v", *snapshot.Name, id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SnapshotChainResource struct {
+}
+
+func TestAccSnapshotChain_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_snapshot_chain", "test")
+	r := SnapshotChainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 2, "1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("snapshot_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("latest_snapshot_id").Exists(),
+			),
+		},
+		data.ImportStep("retention_count", "triggers"),
+	})
+}
+
+func TestAccSnapshotChain_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_snapshot_chain", "test")
+	r := SnapshotChainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 2, "1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSnapshotChain_rolling(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_snapshot_chain", "test")
+	r := SnapshotChainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 2, "1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("snapshot_ids.#").HasValue("1"),
+			),
+		},
+		{
+			Config: r.basic(data, 2, "2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("snapshot_ids.#").HasValue("2"),
+			),
+		},
+		{
+			// the oldest snapshot should be removed once the retention count is exceeded
+			Config: r.basic(data, 2, "3"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("snapshot_ids.#").HasValue("2"),
+			),
+		},
+		{
+			Config: r.basic(data, 1, "3"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("snapshot_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("retention_count", "triggers"),
+	})
+}
+
+func TestAccSnapshotChain_tags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_snapshot_chain", "test")
+	r := SnapshotChainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.tags(data, "Production"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+				check.That(data.ResourceName).Key("tags.ENV").HasValue("Production"),
+			),
+		},
+		data.ImportStep("retention_count", "triggers"),
+		{
+			Config: r.tags(data, "Staging"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+				check.That(data.ResourceName).Key("tags.ENV").HasValue("Staging"),
+			),
+		},
+		data.ImportStep("retention_count", "triggers"),
+	})
+}
+
+func (r SnapshotChainResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SnapshotChainID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	iterator, err := clients.Compute.SnapshotsClient.ListByResourceGroupComplete(ctx, id.ResourceGroup)
+	if err != nil {
+		return nil, fmt.Errorf("listing Snapshots for %s: %+v", *id, err)
+	}
+	for iterator.NotDone() {
+		snapshot := iterator.Value()
+		if v, ok := snapshot.Tags["azurerm-snapshot-chain"]; ok && v != nil && *v == id.Name {
+			return utils.Bool(true), nil
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Snapshots for %s: %+v", *id, err)
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r SnapshotChainResource) basic(data acceptance.TestData, retentionCount int, trigger string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_snapshot_chain" "test" {
+  name                = "acctestssc-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  managed_disk_id     = azurerm_managed_disk.test.id
+  retention_count     = %d
+
+  triggers = {
+    backup = "%s"
+  }
+}
+`, r.template(data), data.RandomInteger, retentionCount, trigger)
+}
+
+func (r SnapshotChainResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_snapshot_chain" "import" {
+  name                = azurerm_snapshot_chain.test.name
+  location            = azurerm_snapshot_chain.test.location
+  resource_group_name = azurerm_snapshot_chain.test.resource_group_name
+  managed_disk_id     = azurerm_snapshot_chain.test.managed_disk_id
+  retention_count     = azurerm_snapshot_chain.test.retention_count
+}
+`, r.basic(data, 2, "1"))
+}
+
+func (r SnapshotChainResource) tags(data acceptance.TestData, environment string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_snapshot_chain" "test" {
+  name                = "acctestssc-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  managed_disk_id     = azurerm_managed_disk.test.id
+  retention_count     = 2
+
+  tags = {
+    ENV = "%s"
+  }
+}
+`, r.template(data), data.RandomInteger, environment)
+}
+
+func (SnapshotChainResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "acctestmd-%[1]d"
+  location             = azurerm_resource_group.test.location
+  resource_group_name  = azurerm_resource_group.test.name
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = "10"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,141 @@
+package compute
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestSnapshotChainSnapshotName(t *testing.T) {
+	now := time.Date(2021, 12, 25, 13, 14, 15, 0, time.FixedZone("UTC+2", 2*60*60))
+	expected := "chain1-20211225111415"
+
+	if actual := snapshotChainSnapshotName("chain1", now); actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestSortSnapshotChainSnapshots(t *testing.T) {
+	snapshot := func(name string, created *time.Time) compute.Snapshot {
+		props := &compute.SnapshotProperties{}
+		if created != nil {
+			props.TimeCreated = &date.Time{Time: *created}
+		}
+		return compute.Snapshot{
+			Name:               utils.String(name),
+			SnapshotProperties: props,
+		}
+	}
+	timestamp := func(hour int) *time.Time {
+		v := time.Date(2021, 12, 25, hour, 0, 0, 0, time.UTC)
+		return &v
+	}
+
+	testData := []struct {
+		name     string
+		input    []compute.Snapshot
+		expected []string
+	}{
+		{
+			name:     "empty",
+			input:    []compute.Snapshot{},
+			expected: []string{},
+		},
+		{
+			name: "ordered by creation time",
+			input: []compute.Snapshot{
+				snapshot("c", timestamp(3)),
+				snapshot("a", timestamp(1)),
+				snapshot("b", timestamp(2)),
+			},
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name: "creation time takes precedence over the name",
+			input: []compute.Snapshot{
+				snapshot("a", timestamp(3)),
+				snapshot("b", timestamp(1)),
+			},
+			expected: []string{"b", "a"},
+		},
+		{
+			name: "falls back to the name without a creation time",
+			input: []compute.Snapshot{
+				snapshot("chain-20211225120000", nil),
+				snapshot("chain-20211225110000", nil),
+			},
+			expected: []string{"chain-20211225110000", "chain-20211225120000"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		sortSnapshotChainSnapshots(v.input)
+		if len(v.input) != len(v.expected) {
+			t.Fatalf("Expected %d Snapshots but got %d", len(v.expected), len(v.input))
+		}
+		for i, expected := range v.expected {
+			if actual := *v.input[i].Name; actual != expected {
+				t.Fatalf("Expected Snapshot %d to be %q but got %q", i, expected, actual)
+			}
+		}
+	}
+}
+
+func TestSnapshotChainSnapshotsToPrune(t *testing.T) {
+	snapshots := []compute.Snapshot{
+		{Name: utils.String("a")},
+		{Name: utils.String("b")},
+		{Name: utils.String("c")},
+	}
+
+	testData := []struct {
+		name           string
+		retentionCount int
+		expected       []string
+	}{
+		{
+			name:           "fewer than the retention count",
+			retentionCount: 5,
+			expected:       []string{},
+		},
+		{
+			name:           "equal to the retention count",
+			retentionCount: 3,
+			expected:       []string{},
+		},
+		{
+			name:           "oldest snapshot exceeds the retention count",
+			retentionCount: 2,
+			expected:       []string{"a"},
+		},
+		{
+			name:           "only the latest snapshot is retained",
+			retentionCount: 1,
+			expected:       []string{"a", "b"},
+		},
+		{
+			name:           "all snapshots are removed",
+			retentionCount: 0,
+			expected:       []string{"a", "b", "c"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual := snapshotChainSnapshotsToPrune(snapshots, v.retentionCount)
+		if len(actual) != len(v.expected) {
+			t.Fatalf("Expected %d Snapshots to be pruned but got %d", len(v.expected), len(actual))
+		}
+		for i, expected := range v.expected {
+			if *actual[i].Name != expected {
+				t.Fatalf("Expected Snapshot %d to be %q but got %q", i, expected, *actual[i].Name)
+			}
+		}
+	}
+}
@@ -1,8 +1,10 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
@@ -55,6 +57,7 @@ func resourceSnapshot() *pluginsdk.Resource {
 				Required: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(compute.DiskCreateOptionCopy),
+					string(compute.DiskCreateOptionCopyStart),
 					string(compute.DiskCreateOptionImport),
 				}, true),
 				DiffSuppressFunc: suppress.CaseDifference,
@@ -86,6 +89,13 @@ func resourceSnapshot() *pluginsdk.Resource {
 
 			"encryption_settings": encryptionSettingsSchema(),
 
+			"incremental_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -115,12 +125,25 @@ func resourceSnapshotCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	incrementalEnabled := d.Get("incremental_enabled").(bool)
+	isCopyStart := strings.EqualFold(createOption, string(compute.DiskCreateOptionCopyStart))
+	if isCopyStart {
+		// copying a snapshot into another region is only supported for incremental snapshots
+		if !incrementalEnabled {
+			return fmt.Errorf("`incremental_enabled` must be set to `true` when `create_option` is set to `CopyStart`")
+		}
+		if _, ok := d.GetOk("source_resource_id"); !ok {
+			return fmt.Errorf("`source_resource_id` must be specified when `create_option` is set to `CopyStart`")
+		}
+	}
+
 	properties := compute.Snapshot{
 		Location: utils.String(location),
 		SnapshotProperties: &compute.SnapshotProperties{
 			CreationData: &compute.CreationData{
 				CreateOption: compute.DiskCreateOption(createOption),
 			},
+			Incremental: utils.Bool(incrementalEnabled),
 		},
 		Tags: tags.Expand(t),
 	}
@@ -157,6 +180,26 @@ func resourceSnapshotCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("waiting on create/update future for Snapshot %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
+	// a `CopyStart` completes once the Snapshot has been created, however the data is copied in the background
+	// so we need to wait for this to finish before the Snapshot can be used
+	if isCopyStart && d.IsNewResource() {
+		log.Printf("[DEBUG] Waiting for the background copy of Snapshot %q (Resource Group %q) to complete..", name, resourceGroup)
+		deadline, ok := ctx.Deadline()
+		if !ok {
+			return fmt.Errorf("context had no deadline")
+		}
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending:    []string{"Copying"},
+			Target:     []string{"Completed"},
+			Refresh:    snapshotCopyStateRefreshFunc(ctx, client, resourceGroup, name),
+			MinTimeout: 15 * time.Second,
+			Timeout:    time.Until(deadline),
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("waiting for the background copy of Snapshot %q (Resource Group %q) to complete: %+v", name, resourceGroup, err)
+		}
+	}
+
 	resp, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
 		return fmt.Errorf("issuing get request for Snapshot %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -203,6 +246,12 @@ func resourceSnapshotRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			}
 		}
 
+		incrementalEnabled := false
+		if props.Incremental != nil {
+			incrementalEnabled = *props.Incremental
+		}
+		d.Set("incremental_enabled", incrementalEnabled)
+
 		if props.DiskSizeGB != nil {
 			d.Set("disk_size_gb", int(*props.DiskSizeGB))
 		}
@@ -236,3 +285,21 @@ func resourceSnapshotDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func snapshotCopyStateRefreshFunc(ctx context.Context, client *compute.SnapshotsClient, resourceGroup, name string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving Snapshot %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if props := resp.SnapshotProperties; props != nil && props.CompletionPercent != nil {
+			log.Printf("[DEBUG] Background copy of Snapshot %q (Resource Group %q) is %.0f%% complete", name, resourceGroup, *props.CompletionPercent)
+			if *props.CompletionPercent < 100 {
+				return resp, "Copying", nil
+			}
+		}
+
+		return resp, "Completed", nil
+	}
+}
@@ -0,0 +1,71 @@
+package validate
+
+import "testing"
+
+func TestCapacityReservationGroupName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// basic example
+			input:    "hello",
+			expected: true,
+		},
+		{
+			// single character
+			input:    "a",
+			expected: true,
+		},
+		{
+			// can't start with an underscore
+			input:    "_hello",
+			expected: false,
+		},
+		{
+			// can't start with a hyphen
+			input:    "-hello",
+			expected: false,
+		},
+		{
+			// can end with an underscore
+			input:    "hello_",
+			expected: true,
+		},
+		{
+			// can't end with a period
+			input:    "hello.",
+			expected: false,
+		},
+		{
+			// period and hyphen in middle
+			input:    "hello.wor-ld",
+			expected: true,
+		},
+		{
+			// 80 characters
+			input:    "abcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcde",
+			expected: true,
+		},
+		{
+			// 81 characters
+			input:    "abcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdef",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q...", v.input)
+
+		_, errors := CapacityReservationGroupName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
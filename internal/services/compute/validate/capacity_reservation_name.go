@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func CapacityReservationName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	// the name can contain alphanumerics, underscores, periods and hyphens, must start with an alphanumeric
+	// and end with an alphanumeric or underscore
+	if matched := regexp.MustCompile(`^[^_\W]([\w-.]{0,78}\w)?$`).MatchString(v); !matched {
+		errors = append(errors, fmt.Errorf("%s must be between 1 - 80 characters long, start with an alphanumeric, end with an alphanumeric or underscore and can contain only alphanumerics, underscores, periods and hyphens", k))
+	}
+	return
+}
@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func GalleryApplicationName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	// the name must begin and end with an alphanumeric character and can contain full stops, dashes and underscores
+	if !regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._-]*[A-Za-z0-9])?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%s must begin and end with an alphanumeric character and can only contain alphanumeric, full stops, dashes and underscores. Got %q.", k, value))
+	}
+
+	if length := len(value); length > 80 {
+		errors = append(errors, fmt.Errorf("%s can be up to 80 characters, currently %d.", k, length))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,69 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGalleryApplicationName(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ShouldError bool
+	}{
+		{
+			Input:       "",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello.123",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello_123",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello-123",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello,123",
+			ShouldError: true,
+		},
+		{
+			Input:       "-hello",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello.",
+			ShouldError: true,
+		},
+		{
+			Input:       strings.Repeat("a", 80),
+			ShouldError: false,
+		},
+		{
+			Input:       strings.Repeat("a", 81),
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := GalleryApplicationName(tc.Input, "test")
+
+			hasErrors := len(errors) > 0
+			if !hasErrors && tc.ShouldError {
+				t.Fatalf("Expected an error but didn't get one for %q", tc.Input)
+			}
+
+			if hasErrors && !tc.ShouldError {
+				t.Fatalf("Expected to get no errors for %q but got %d", tc.Input, len(errors))
+			}
+		})
+	}
+}
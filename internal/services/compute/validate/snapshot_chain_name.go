@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func SnapshotChainName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	// the name is used as the prefix for the name of each Snapshot within the chain, which is suffixed with
+	// a hyphen and a 14 character timestamp - as such this is limited to 65 of the 80 characters available
+	if matched := regexp.MustCompile(`^[A-Za-z0-9_-]{1,65}$`).MatchString(v); !matched {
+		errors = append(errors, fmt.Errorf("%s must be between 1 - 65 characters long and can contain only alphanumerics, underscores and hyphens", k))
+	}
+	return
+}
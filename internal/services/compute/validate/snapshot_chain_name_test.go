@@ -0,0 +1,56 @@
+package validate
+
+import "testing"
+
+func TestSnapshotChainName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// basic example
+			input:    "hello",
+			expected: true,
+		},
+		{
+			// can contain underscores and hyphens
+			input:    "hello_world-1",
+			expected: true,
+		},
+		{
+			// can't contain periods
+			input:    "hello.world",
+			expected: false,
+		},
+		{
+			// can't contain spaces
+			input:    "hello world",
+			expected: false,
+		},
+		{
+			// 65 chars
+			input:    "abcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcde",
+			expected: true,
+		},
+		{
+			// 66 chars
+			input:    "abcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdeabcdef",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q...", v.input)
+
+		_, errors := SnapshotChainName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
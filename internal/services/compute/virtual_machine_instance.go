@@ -1,9 +1,22 @@
 package compute
 
 import (
+	"context"
+	"fmt"
+	"log"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	virtualMachinePowerStateDeallocated = "deallocated"
+	virtualMachinePowerStateRunning     = "running"
+	virtualMachinePowerStateStopped     = "stopped"
 )
 
 // virtualMachineShouldBeStarted determines if the Virtual Machine should be started after
@@ -32,3 +45,148 @@ func virtualMachineShouldBeStarted(instanceView compute.VirtualMachineInstanceVi
 
 	return false
 }
+
+// virtualMachinePowerState returns the Power State of the Virtual Machine (e.g. `running` or `deallocated`)
+// from the Instance View - or an empty string if this isn't available
+func virtualMachinePowerState(instanceView compute.VirtualMachineInstanceView) string {
+	if instanceView.Statuses != nil {
+		for _, status := range *instanceView.Statuses {
+			if status.Code == nil {
+				continue
+			}
+
+			state := strings.ToLower(*status.Code)
+			if strings.HasPrefix(state, "powerstate/") {
+				return strings.TrimPrefix(state, "powerstate/")
+			}
+		}
+	}
+
+	return ""
+}
+
+// virtualMachineEvictedSpotInstanceCustomizeDiff flags a Spot Virtual Machine which has been evicted (and as such
+// deallocated) as needing to be started on the next apply, when the `start_evicted_spot_instances` feature is enabled
+func virtualMachineEvictedSpotInstanceCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !meta.(*clients.Client).Features.VirtualMachine.StartEvictedSpotInstances {
+		return nil
+	}
+
+	// an explicitly configured `power_state` takes precedence
+	if v := d.GetRawConfig().GetAttr("power_state"); !v.IsNull() {
+		return nil
+	}
+
+	if d.Get("priority").(string) != string(compute.VirtualMachinePriorityTypesSpot) || d.Get("eviction_policy").(string) != string(compute.VirtualMachineEvictionPolicyTypesDeallocate) {
+		return nil
+	}
+
+	if d.Get("power_state").(string) == virtualMachinePowerStateDeallocated {
+		return d.SetNew("power_state", virtualMachinePowerStateRunning)
+	}
+
+	return nil
+}
+
+func virtualMachinePowerStateSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+		// when this isn't specified the Power State of the Virtual Machine isn't managed
+		Computed: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			virtualMachinePowerStateDeallocated,
+			virtualMachinePowerStateRunning,
+			virtualMachinePowerStateStopped,
+		}, false),
+	}
+}
+
+// updateVirtualMachinePowerState transitions the Virtual Machine into the desired Power State - when the Virtual
+// Machine needs to be shut down this honours the `graceful_shutdown` feature, as is done during deletion
+func updateVirtualMachinePowerState(ctx context.Context, client *compute.VirtualMachinesClient, resourceGroup, name, desired string, gracefulShutdown bool) error {
+	instanceView, err := client.InstanceView(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("retrieving InstanceView for Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	current := virtualMachinePowerState(instanceView)
+
+	log.Printf("[DEBUG] Transitioning Virtual Machine %q (Resource Group %q) from %q to %q..", name, resourceGroup, current, desired)
+	switch desired {
+	case virtualMachinePowerStateRunning:
+		if current == virtualMachinePowerStateRunning {
+			return nil
+		}
+
+		return startVirtualMachine(ctx, client, resourceGroup, name)
+
+	case virtualMachinePowerStateStopped:
+		switch current {
+		case virtualMachinePowerStateStopped, "stopping":
+			return nil
+
+		case virtualMachinePowerStateDeallocated, "deallocating":
+			// a deallocated Virtual Machine has to be started before it can be stopped
+			if err := startVirtualMachine(ctx, client, resourceGroup, name); err != nil {
+				return err
+			}
+		}
+
+		return powerOffVirtualMachine(ctx, client, resourceGroup, name, gracefulShutdown)
+
+	case virtualMachinePowerStateDeallocated:
+		switch current {
+		case virtualMachinePowerStateDeallocated:
+			return nil
+
+		case virtualMachinePowerStateRunning, "starting":
+			// shut the Virtual Machine down first, so that the `graceful_shutdown` feature is honoured
+			if err := powerOffVirtualMachine(ctx, client, resourceGroup, name, gracefulShutdown); err != nil {
+				return err
+			}
+		}
+
+		log.Printf("[DEBUG] Deallocating Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+		future, err := client.Deallocate(ctx, resourceGroup, name, utils.Bool(false))
+		if err != nil {
+			return fmt.Errorf("deallocating Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for deallocation of Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+		log.Printf("[DEBUG] Deallocated Virtual Machine %q (Resource Group %q).", name, resourceGroup)
+
+		return nil
+	}
+
+	return fmt.Errorf("unsupported Power State %q", desired)
+}
+
+func startVirtualMachine(ctx context.Context, client *compute.VirtualMachinesClient, resourceGroup, name string) error {
+	log.Printf("[DEBUG] Starting Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.Start(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("starting Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for start of Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	log.Printf("[DEBUG] Started Virtual Machine %q (Resource Group %q).", name, resourceGroup)
+
+	return nil
+}
+
+func powerOffVirtualMachine(ctx context.Context, client *compute.VirtualMachinesClient, resourceGroup, name string, gracefulShutdown bool) error {
+	log.Printf("[DEBUG] Powering Off Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+	skipShutdown := !gracefulShutdown
+	future, err := client.PowerOff(ctx, resourceGroup, name, utils.Bool(skipShutdown))
+	if err != nil {
+		return fmt.Errorf("powering off Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for power off of Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	log.Printf("[DEBUG] Powered Off Virtual Machine %q (Resource Group %q).", name, resourceGroup)
+
+	return nil
+}
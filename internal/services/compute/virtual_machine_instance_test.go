@@ -79,3 +79,61 @@ func TestVirtualMachineShouldBeStarted(t *testing.T) {
 		}
 	}
 }
+
+func TestVirtualMachinePowerState(t *testing.T) {
+	buildInstanceViewStatus := func(statuses ...string) *[]compute.InstanceViewStatus {
+		results := make([]compute.InstanceViewStatus, 0)
+
+		for _, v := range statuses {
+			results = append(results, compute.InstanceViewStatus{
+				Code: utils.String(v),
+			})
+		}
+
+		return &results
+	}
+
+	testCases := []struct {
+		Name     string
+		Input    *[]compute.InstanceViewStatus
+		Expected string
+	}{
+		{
+			Name:     "None",
+			Input:    nil,
+			Expected: "",
+		},
+		{
+			Name:     "No Power State",
+			Input:    buildInstanceViewStatus("ProvisioningStatus/Creating"),
+			Expected: "",
+		},
+		{
+			Name:     "Running",
+			Input:    buildInstanceViewStatus("ProvisioningStatus/succeeded", "PowerState/running"),
+			Expected: "running",
+		},
+		{
+			Name:     "Deallocated",
+			Input:    buildInstanceViewStatus("ProvisioningStatus/succeeded", "PowerState/deallocated"),
+			Expected: "deallocated",
+		},
+		{
+			Name:     "Stopped",
+			Input:    buildInstanceViewStatus("ProvisioningStatus/updating", "PowerState/Stopped"),
+			Expected: "stopped",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Running %q..", testCase.Name)
+
+		instanceView := compute.VirtualMachineInstanceView{
+			Statuses: testCase.Input,
+		}
+		result := virtualMachinePowerState(instanceView)
+		if result != testCase.Expected {
+			t.Fatalf("Expected %q but got %q", testCase.Expected, result)
+		}
+	}
+}
@@ -624,6 +624,8 @@ func resourceVirtualMachine() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"power_state": virtualMachinePowerStateSchema(),
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -767,6 +769,14 @@ func resourceVirtualMachineCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 
 	d.SetId(*read.ID)
 
+	if d.HasChange("power_state") {
+		if powerState := d.Get("power_state").(string); powerState != "" {
+			if err := updateVirtualMachinePowerState(ctx, client, resGroup, name, powerState, meta.(*clients.Client).Features.VirtualMachine.GracefulShutdown); err != nil {
+				return err
+			}
+		}
+	}
+
 	ipAddress, err := determineVirtualMachineIPAddress(ctx, meta, read.VirtualMachineProperties)
 	if err != nil {
 		return fmt.Errorf("determining IP Address for Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
@@ -826,6 +836,12 @@ func resourceVirtualMachineRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("setting `identity`: %+v", err)
 	}
 
+	instanceView, err := vmclient.InstanceView(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving InstanceView for Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	d.Set("power_state", virtualMachinePowerState(instanceView))
+
 	if props := resp.VirtualMachineProperties; props != nil {
 		if availabilitySet := props.AvailabilitySet; availabilitySet != nil {
 			// Lowercase due to incorrect capitalisation of resource group name in
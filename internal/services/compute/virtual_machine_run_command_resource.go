@@ -0,0 +1,475 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceVirtualMachineRunCommand() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVirtualMachineRunCommandCreateUpdate,
+		Read:   resourceVirtualMachineRunCommandRead,
+		Update: resourceVirtualMachineRunCommandCreateUpdate,
+		Delete: resourceVirtualMachineRunCommandDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.VirtualMachineRunCommandID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"virtual_machine_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualMachineID,
+			},
+
+			// changes to the source cause the script to be run again
+			"source": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"script": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+						},
+
+						"script_uri": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+							ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+						},
+
+						"command_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+						},
+					},
+				},
+			},
+
+			"parameter": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"value": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			// due to the sensitive nature, these are not returned by the API
+			"protected_parameter": {
+				Type:      pluginsdk.TypeList,
+				Optional:  true,
+				Sensitive: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"value": {
+							Type:      pluginsdk.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
+			"run_as_user": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"run_as_password": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"run_as_user"},
+			},
+
+			"timeout_in_seconds": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 5400),
+			},
+
+			"output_blob_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+
+			"error_blob_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+
+			"tags": tags.Schema(),
+
+			"instance_view": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"execution_state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"execution_message": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"exit_code": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"output": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"error_message": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"start_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"end_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVirtualMachineRunCommandCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMRunCommandsClient
+	vmClient := meta.(*clients.Client).Compute.VMClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	virtualMachineId, err := parse.VirtualMachineID(d.Get("virtual_machine_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewVirtualMachineRunCommandID(virtualMachineId.SubscriptionId, virtualMachineId.ResourceGroup, virtualMachineId.Name, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_virtual_machine_run_command", id.ID())
+		}
+	}
+
+	virtualMachine, err := vmClient.Get(ctx, virtualMachineId.ResourceGroup, virtualMachineId.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *virtualMachineId, err)
+	}
+	if virtualMachine.Location == nil || *virtualMachine.Location == "" {
+		return fmt.Errorf("retrieving %s: `location` was nil", *virtualMachineId)
+	}
+
+	properties := compute.VirtualMachineRunCommandProperties{
+		Source:              expandVirtualMachineRunCommandSource(d.Get("source").([]interface{})),
+		Parameters:          expandVirtualMachineRunCommandParameters(d.Get("parameter").([]interface{})),
+		ProtectedParameters: expandVirtualMachineRunCommandParameters(d.Get("protected_parameter").([]interface{})),
+		AsyncExecution:      utils.Bool(false),
+	}
+
+	if v := d.Get("run_as_user").(string); v != "" {
+		properties.RunAsUser = utils.String(v)
+	}
+	if v := d.Get("run_as_password").(string); v != "" {
+		properties.RunAsPassword = utils.String(v)
+	}
+	if v := d.Get("timeout_in_seconds").(int); v != 0 {
+		properties.TimeoutInSeconds = utils.Int32(int32(v))
+	}
+	if v := d.Get("output_blob_uri").(string); v != "" {
+		properties.OutputBlobURI = utils.String(v)
+	}
+	if v := d.Get("error_blob_uri").(string); v != "" {
+		properties.ErrorBlobURI = utils.String(v)
+	}
+
+	runCommand := compute.VirtualMachineRunCommand{
+		Location:                           virtualMachine.Location,
+		VirtualMachineRunCommandProperties: &properties,
+		Tags:                               tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, runCommand)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	// the Run Command provisions successfully even when the script itself fails, so check the outcome
+	resp, err := client.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, "instanceView")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if props := resp.VirtualMachineRunCommandProperties; props != nil && props.InstanceView != nil {
+		instanceView := props.InstanceView
+		switch instanceView.ExecutionState {
+		case compute.ExecutionStateFailed, compute.ExecutionStateTimedOut, compute.ExecutionStateCanceled:
+			exitCode := 0
+			if instanceView.ExitCode != nil {
+				exitCode = int(*instanceView.ExitCode)
+			}
+			return fmt.Errorf("the script for %s completed with the execution state %q (exit code %d): %s", id, string(instanceView.ExecutionState), exitCode, utils.NormalizeNilableString(instanceView.Error))
+		}
+	}
+
+	return resourceVirtualMachineRunCommandRead(d, meta)
+}
+
+func resourceVirtualMachineRunCommandRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMRunCommandsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineRunCommandID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, "instanceView")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RunCommandName)
+	d.Set("virtual_machine_id", parse.NewVirtualMachineID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName).ID())
+
+	if props := resp.VirtualMachineRunCommandProperties; props != nil {
+		if err := d.Set("source", flattenVirtualMachineRunCommandSource(props.Source, d.Get("source").([]interface{}))); err != nil {
+			return fmt.Errorf("setting `source`: %+v", err)
+		}
+
+		if err := d.Set("parameter", flattenVirtualMachineRunCommandParameters(props.Parameters)); err != nil {
+			return fmt.Errorf("setting `parameter`: %+v", err)
+		}
+
+		d.Set("run_as_user", props.RunAsUser)
+
+		timeoutInSeconds := 0
+		if props.TimeoutInSeconds != nil {
+			timeoutInSeconds = int(*props.TimeoutInSeconds)
+		}
+		d.Set("timeout_in_seconds", timeoutInSeconds)
+
+		if err := d.Set("instance_view", flattenVirtualMachineRunCommandInstanceView(props.InstanceView)); err != nil {
+			return fmt.Errorf("setting `instance_view`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceVirtualMachineRunCommandDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMRunCommandsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineRunCommandID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandVirtualMachineRunCommandSource(input []interface{}) *compute.VirtualMachineRunCommandScriptSource {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := &compute.VirtualMachineRunCommandScriptSource{}
+
+	if v := raw["script"].(string); v != "" {
+		output.Script = utils.String(v)
+	}
+	if v := raw["script_uri"].(string); v != "" {
+		output.ScriptURI = utils.String(v)
+	}
+	if v := raw["command_id"].(string); v != "" {
+		output.CommandID = utils.String(v)
+	}
+
+	return output
+}
+
+func flattenVirtualMachineRunCommandSource(input *compute.VirtualMachineRunCommandScriptSource, existing []interface{}) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	// the Script URI can contain a SAS Token and isn't returned by the API, so we retain this from the config
+	scriptUri := ""
+	if len(existing) > 0 && existing[0] != nil {
+		scriptUri = existing[0].(map[string]interface{})["script_uri"].(string)
+	}
+	if input.ScriptURI != nil && *input.ScriptURI != "" {
+		scriptUri = *input.ScriptURI
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"script":     utils.NormalizeNilableString(input.Script),
+			"script_uri": scriptUri,
+			"command_id": utils.NormalizeNilableString(input.CommandID),
+		},
+	}
+}
+
+func expandVirtualMachineRunCommandParameters(input []interface{}) *[]compute.RunCommandInputParameter {
+	output := make([]compute.RunCommandInputParameter, 0)
+	for _, item := range input {
+		raw := item.(map[string]interface{})
+		output = append(output, compute.RunCommandInputParameter{
+			Name:  utils.String(raw["name"].(string)),
+			Value: utils.String(raw["value"].(string)),
+		})
+	}
+	return &output
+}
+
+func flattenVirtualMachineRunCommandParameters(input *[]compute.RunCommandInputParameter) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, item := range *input {
+		output = append(output, map[string]interface{}{
+			"name":  utils.NormalizeNilableString(item.Name),
+			"value": utils.NormalizeNilableString(item.Value),
+		})
+	}
+	return output
+}
+
+func flattenVirtualMachineRunCommandInstanceView(input *compute.VirtualMachineRunCommandInstanceView) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	exitCode := 0
+	if input.ExitCode != nil {
+		exitCode = int(*input.ExitCode)
+	}
+
+	startTime := ""
+	if input.StartTime != nil {
+		startTime = input.StartTime.Format(time.RFC3339)
+	}
+
+	endTime := ""
+	if input.EndTime != nil {
+		endTime = input.EndTime.Format(time.RFC3339)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"execution_state":   string(input.ExecutionState),
+			"execution_message": utils.NormalizeNilableString(input.ExecutionMessage),
+			"exit_code":         exitCode,
+			"output":            utils.NormalizeNilableString(input.Output),
+			"error_message":     utils.NormalizeNilableString(input.Error),
+			"start_time":        startTime,
+			"end_time":          endTime,
+		},
+	}
+}
@@ -0,0 +1,292 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VirtualMachineRunCommandResource struct{}
+
+func TestAccVirtualMachineRunCommand_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_run_command", "test")
+	r := VirtualMachineRunCommandResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "hello"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_view.0.exit_code").HasValue("0"),
+				check.That(data.ResourceName).Key("instance_view.0.execution_state").HasValue("Succeeded"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualMachineRunCommand_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_run_command", "test")
+	r := VirtualMachineRunCommandResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "hello"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualMachineRunCommand_scriptChanged(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_run_command", "test")
+	r := VirtualMachineRunCommandResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "hello"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_view.0.output").HasValue("hello\n"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "world"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_view.0.output").HasValue("world\n"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualMachineRunCommand_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_run_command", "test")
+	r := VirtualMachineRunCommandResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_view.0.exit_code").HasValue("0"),
+			),
+		},
+		data.ImportStep("protected_parameter", "output_blob_uri", "error_blob_uri"),
+	})
+}
+
+func (r VirtualMachineRunCommandResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.VirtualMachineRunCommandID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Compute.VMRunCommandsClient.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.VirtualMachineRunCommandProperties != nil), nil
+}
+
+func (r VirtualMachineRunCommandResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctni-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_linux_virtual_machine" "test" {
+  name                            = "acctestVM-%[1]d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  size                            = "Standard_F2"
+  admin_username                  = "adminuser"
+  admin_password                  = "P@ssw0rd1234!"
+  disable_password_authentication = false
+  network_interface_ids           = [azurerm_network_interface.test.id]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r VirtualMachineRunCommandResource) basic(data acceptance.TestData, message string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_run_command" "test" {
+  name               = "acctestvmrc-%d"
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+
+  source {
+    script = "echo '%s'"
+  }
+}
+`, r.template(data), data.RandomInteger, message)
+}
+
+func (r VirtualMachineRunCommandResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_run_command" "import" {
+  name               = azurerm_virtual_machine_run_command.test.name
+  virtual_machine_id = azurerm_virtual_machine_run_command.test.virtual_machine_id
+
+  source {
+    script = "echo 'hello'"
+  }
+}
+`, r.basic(data, "hello"))
+}
+
+func (r VirtualMachineRunCommandResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[2]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "output"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "output" {
+  name                   = "output.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Append"
+}
+
+resource "azurerm_storage_blob" "error" {
+  name                   = "error.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Append"
+}
+
+data "azurerm_storage_account_sas" "test" {
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  https_only        = true
+  signed_version    = "2019-10-10"
+
+  resource_types {
+    service   = false
+    container = false
+    object    = true
+  }
+
+  services {
+    blob  = true
+    queue = false
+    table = false
+    file  = false
+  }
+
+  start  = "2022-01-01T00:00:00Z"
+  expiry = "2032-01-01T00:00:00Z"
+
+  permissions {
+    read    = true
+    write   = true
+    delete  = false
+    list    = false
+    add     = true
+    create  = true
+    update  = false
+    process = false
+  }
+}
+
+resource "azurerm_virtual_machine_run_command" "test" {
+  name               = "acctestvmrc-%[3]d"
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+  run_as_user        = "adminuser"
+  run_as_password    = "P@ssw0rd1234!"
+  timeout_in_seconds = 600
+  output_blob_uri    = "${azurerm_storage_blob.output.id}${data.azurerm_storage_account_sas.test.sas}"
+  error_blob_uri     = "${azurerm_storage_blob.error.id}${data.azurerm_storage_account_sas.test.sas}"
+
+  source {
+    script = "echo $GREETING $SECRET"
+  }
+
+  parameter {
+    name  = "GREETING"
+    value = "hello"
+  }
+
+  protected_parameter {
+    name  = "SECRET"
+    value = "s3cr3t!"
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomString, data.RandomInteger)
+}
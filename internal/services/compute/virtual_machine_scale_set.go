@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/rickb777/date/period"
 )
 
 func VirtualMachineScaleSetAdditionalCapabilitiesSchema() *pluginsdk.Schema {
@@ -1368,6 +1369,97 @@ func FlattenVirtualMachineScaleSetRollingUpgradePolicy(input *compute.RollingUpg
 	}
 }
 
+func VirtualMachineScaleSetInstanceUpgradePolicySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"batch_size": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"pause_time_between_batches": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "PT0S",
+					ValidateFunc: azValidate.ISO8601Duration,
+				},
+				"health_check_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"health_check_timeout": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "PT10M",
+					ValidateFunc: azValidate.ISO8601Duration,
+				},
+				"upgrade_action": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Default:  virtualMachineScaleSetInstanceUpgradeActionUpdateAndReimage,
+					ValidateFunc: validation.StringInSlice([]string{
+						virtualMachineScaleSetInstanceUpgradeActionReimage,
+						virtualMachineScaleSetInstanceUpgradeActionUpdate,
+						virtualMachineScaleSetInstanceUpgradeActionUpdateAndReimage,
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineScaleSetInstanceUpgradePolicy(input []interface{}) (*virtualMachineScaleSetInstanceUpgradePolicy, error) {
+	// when this block isn't specified the instances are only rolled when the feature toggle is enabled
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	pauseTimeBetweenBatches, err := period.Parse(raw["pause_time_between_batches"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("parsing `pause_time_between_batches`: %+v", err)
+	}
+
+	healthCheckTimeout, err := period.Parse(raw["health_check_timeout"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("parsing `health_check_timeout`: %+v", err)
+	}
+
+	return &virtualMachineScaleSetInstanceUpgradePolicy{
+		BatchSize:               raw["batch_size"].(int),
+		PauseTimeBetweenBatches: pauseTimeBetweenBatches.DurationApprox(),
+		HealthCheckEnabled:      raw["health_check_enabled"].(bool),
+		HealthCheckTimeout:      healthCheckTimeout.DurationApprox(),
+		UpgradeAction:           raw["upgrade_action"].(string),
+	}, nil
+}
+
+func validateVirtualMachineScaleSetInstanceUpgradePolicy(upgradeMode compute.UpgradeMode, healthProbeId string, hasHealthExtension bool, input []interface{}) error {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	if upgradeMode != compute.UpgradeModeManual {
+		return fmt.Errorf("an `instance_upgrade_policy` block can only be specified when `upgrade_mode` is set to %q", string(compute.UpgradeModeManual))
+	}
+
+	raw := input[0].(map[string]interface{})
+	// the health of each instance is read from the Load Balancer Health Probe when one is specified, otherwise from the
+	// Instance View - which is only populated by the Application Health extension
+	if raw["health_check_enabled"].(bool) && healthProbeId == "" && !hasHealthExtension {
+		return fmt.Errorf("either `health_probe_id` or an `extension` of type `ApplicationHealthLinux` or `ApplicationHealthWindows` must be specified when `health_check_enabled` is set to `true` within the `instance_upgrade_policy` block")
+	}
+
+	return nil
+}
+
 func VirtualMachineScaleSetTerminateNotificationSchema() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
 		Type:     pluginsdk.TypeList,
@@ -1610,6 +1702,21 @@ func virtualMachineScaleSetExtensionHash(v interface{}) int {
 	return pluginsdk.HashString(buf.String())
 }
 
+func virtualMachineScaleSetHasHealthExtension(input []interface{}) bool {
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+
+		extensionType := v.(map[string]interface{})["type"].(string)
+		if extensionType == "ApplicationHealthLinux" || extensionType == "ApplicationHealthWindows" {
+			return true
+		}
+	}
+
+	return false
+}
+
 func expandVirtualMachineScaleSetExtensions(input []interface{}) (extensionProfile *compute.VirtualMachineScaleSetExtensionProfile, hasHealthExtension bool, err error) {
 	extensionProfile = &compute.VirtualMachineScaleSetExtensionProfile{}
 	if len(input) == 0 {
@@ -4,11 +4,17 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	loadBalancerParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -22,10 +28,53 @@ type virtualMachineScaleSetUpdateMetaData struct {
 	// do we need to roll the instances in this scale set?
 	UpdateInstances bool
 
+	// how should the instances be rolled when the upgrade mode is Manual? when specified this opts this
+	// Scale Set into rolling the instances, regardless of the feature toggle
+	InstanceUpgradePolicy *virtualMachineScaleSetInstanceUpgradePolicy
+
+	// the ID of the Load Balancer Health Probe used to determine the health of each instance, when set
+	HealthProbeID string
+
 	Client   *client.Client
 	Existing compute.VirtualMachineScaleSet
 	ID       *parse.VirtualMachineScaleSetId
 	OSType   compute.OperatingSystemTypes
+
+	// used to determine the health of each instance from the Load Balancer Health Probe
+	LoadBalancersClient     *network.LoadBalancersClient
+	MetricsClient           *insights.MetricsClient
+	NetworkInterfacesClient *network.InterfacesClient
+}
+
+const (
+	virtualMachineScaleSetInstanceUpgradeActionReimage          = "Reimage"
+	virtualMachineScaleSetInstanceUpgradeActionUpdate           = "Update"
+	virtualMachineScaleSetInstanceUpgradeActionUpdateAndReimage = "UpdateAndReimage"
+)
+
+const (
+	virtualMachineScaleSetInstanceHealthStateHealthy     = "Healthy"
+	virtualMachineScaleSetInstanceHealthStateNotReported = "NotReported"
+	virtualMachineScaleSetInstanceHealthStateUnhealthy   = "Unhealthy"
+)
+
+type virtualMachineScaleSetInstanceUpgradePolicy struct {
+	// how many instances should be rolled at once?
+	BatchSize int
+
+	// how long should we wait between each batch of instances?
+	PauseTimeBetweenBatches time.Duration
+
+	// should we wait for each batch of instances to report as healthy before moving onto the next batch?
+	// the health of an instance is reported by the Load Balancer Health Probe, or otherwise by the Application
+	// Health extension
+	HealthCheckEnabled bool
+
+	// how long should we wait for each batch of instances to report as healthy before aborting?
+	HealthCheckTimeout time.Duration
+
+	// should the instances be updated to the latest model, reimaged, or both?
+	UpgradeAction string
 }
 
 func (metadata virtualMachineScaleSetUpdateMetaData) performUpdate(ctx context.Context, update compute.VirtualMachineScaleSetUpdate) error {
@@ -44,7 +93,7 @@ func (metadata virtualMachineScaleSetUpdateMetaData) performUpdate(ctx context.C
 
 	// if we update the SKU, we also need to subsequently roll the instances using the `UpdateInstances` API
 	if metadata.UpdateInstances {
-		userWantsToRollInstances := metadata.CanRollInstancesWhenRequired
+		userWantsToRollInstances := metadata.CanRollInstancesWhenRequired || metadata.InstanceUpgradePolicy != nil
 		upgradeMode := metadata.Existing.VirtualMachineScaleSetProperties.UpgradePolicy.Mode
 
 		if userWantsToRollInstances {
@@ -121,9 +170,22 @@ func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForAutomati
 	return nil
 }
 
+// instanceUpgradePolicy returns the configured `instance_upgrade_policy` - or when this block isn't specified the
+// instances are rolled one at a time, being updated and then reimaged
+func (metadata virtualMachineScaleSetUpdateMetaData) instanceUpgradePolicy() virtualMachineScaleSetInstanceUpgradePolicy {
+	if metadata.InstanceUpgradePolicy != nil {
+		return *metadata.InstanceUpgradePolicy
+	}
+
+	return virtualMachineScaleSetInstanceUpgradePolicy{
+		BatchSize:     1,
+		UpgradeAction: virtualMachineScaleSetInstanceUpgradeActionUpdateAndReimage,
+	}
+}
+
 func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForManualUpgradePolicy(ctx context.Context) error {
-	client := metadata.Client.VMScaleSetClient
 	id := metadata.ID
+	policy := metadata.instanceUpgradePolicy()
 
 	log.Printf("[DEBUG] Rolling the VM Instances for %s Virtual Machine Scale Set %q (Resource Group %q)..", metadata.OSType, id.Name, id.ResourceGroup)
 	instancesClient := metadata.Client.VMScaleSetVMsClient
@@ -139,7 +201,7 @@ func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForManualUp
 		props := instance.VirtualMachineScaleSetVMProperties
 		if props != nil && instance.InstanceID != nil {
 			latestModel := props.LatestModelApplied
-			if latestModel != nil || !*latestModel {
+			if latestModel == nil || !*latestModel {
 				instanceIdsToRoll = append(instanceIdsToRoll, *instance.InstanceID)
 			}
 		}
@@ -149,44 +211,413 @@ func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForManualUp
 		}
 	}
 
-	// TODO: there's a performance enhancement to do batches here, but this is fine for a first pass
-	for _, instanceId := range instanceIdsToRoll {
-		instanceIds := []string{instanceId}
+	batches := virtualMachineScaleSetInstanceBatches(instanceIdsToRoll, policy.BatchSize)
+	for i, batch := range batches {
+		log.Printf("[DEBUG] Rolling batch %d of %d (Instances %q)..", i+1, len(batches), strings.Join(batch, ", "))
+		if err := metadata.rollInstances(ctx, batch); err != nil {
+			// abort here rather than continuing with the next batch, so that we don't take down any further instances
+			failedInstanceIds := metadata.determineFailedInstances(ctx, batch)
+			remainingInstanceIds := make([]string, 0)
+			for _, remaining := range batches[i+1:] {
+				remainingInstanceIds = append(remainingInstanceIds, remaining...)
+			}
 
-		log.Printf("[DEBUG] Updating Instance %q to the Latest Configuration..", instanceId)
+			return fmt.Errorf("rolling the VM Instances for %s Virtual Machine Scale Set %q (Resource Group %q) was aborted in batch %d of %d - Failed Instances: [%s] - Instances which have not been rolled: [%s]: %+v", metadata.OSType, id.Name, id.ResourceGroup, i+1, len(batches), strings.Join(failedInstanceIds, ", "), strings.Join(remainingInstanceIds, ", "), err)
+		}
+		log.Printf("[DEBUG] Rolled batch %d of %d (Instances %q).", i+1, len(batches), strings.Join(batch, ", "))
+
+		if i < len(batches)-1 && policy.PauseTimeBetweenBatches > 0 {
+			log.Printf("[DEBUG] Pausing for %s before rolling the next batch..", policy.PauseTimeBetweenBatches)
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("pausing between batches for %s Virtual Machine Scale Set %q (Resource Group %q): %+v", metadata.OSType, id.Name, id.ResourceGroup, ctx.Err())
+			case <-time.After(policy.PauseTimeBetweenBatches):
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Rolled the VM Instances for %s Virtual Machine Scale Set %q (Resource Group %q).", metadata.OSType, id.Name, id.ResourceGroup)
+	return nil
+}
+
+// rollInstances updates and/or reimages the specified batch of instances (depending on the configured `upgrade_action`)
+// and then optionally waits for them to report as healthy
+func (metadata virtualMachineScaleSetUpdateMetaData) rollInstances(ctx context.Context, instanceIds []string) error {
+	client := metadata.Client.VMScaleSetClient
+	id := metadata.ID
+	policy := metadata.instanceUpgradePolicy()
+
+	if policy.UpgradeAction != virtualMachineScaleSetInstanceUpgradeActionReimage {
+		log.Printf("[DEBUG] Updating Instances %q to the Latest Configuration..", strings.Join(instanceIds, ", "))
 		ids := compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
 			InstanceIds: &instanceIds,
 		}
 		future, err := client.UpdateInstances(ctx, id.ResourceGroup, id.Name, ids)
 		if err != nil {
-			return fmt.Errorf("updating Instance %q (%s VM Scale Set %q / Resource Group %q) to the Latest Configuration: %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+			return fmt.Errorf("updating Instances %q to the Latest Configuration: %+v", strings.Join(instanceIds, ", "), err)
 		}
 
 		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("waiting for update of Instance %q (%s VM Scale Set %q / Resource Group %q) to the Latest Configuration: %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+			return fmt.Errorf("waiting for update of Instances %q to the Latest Configuration: %+v", strings.Join(instanceIds, ", "), err)
 		}
-		log.Printf("[DEBUG] Updated Instance %q to the Latest Configuration.", instanceId)
+		log.Printf("[DEBUG] Updated Instances %q to the Latest Configuration.", strings.Join(instanceIds, ", "))
+	}
 
-		// TODO: does this want to be a separate, user-configurable toggle?
-		log.Printf("[DEBUG] Reimaging Instance %q..", instanceId)
+	if policy.UpgradeAction != virtualMachineScaleSetInstanceUpgradeActionUpdate {
+		log.Printf("[DEBUG] Reimaging Instances %q..", strings.Join(instanceIds, ", "))
 		reimageInput := &compute.VirtualMachineScaleSetReimageParameters{
 			InstanceIds: &instanceIds,
 		}
 		reimageFuture, err := client.Reimage(ctx, id.ResourceGroup, id.Name, reimageInput)
 		if err != nil {
-			return fmt.Errorf("reimaging Instance %q (%s VM Scale Set %q / Resource Group %q): %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+			return fmt.Errorf("reimaging Instances %q: %+v", strings.Join(instanceIds, ", "), err)
 		}
 
 		if err = reimageFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("waiting for reimage of Instance %q (%s VM Scale Set %q / Resource Group %q): %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+			return fmt.Errorf("waiting for reimage of Instances %q: %+v", strings.Join(instanceIds, ", "), err)
 		}
-		log.Printf("[DEBUG] Reimaged Instance %q..", instanceId)
+		log.Printf("[DEBUG] Reimaged Instances %q.", strings.Join(instanceIds, ", "))
+	}
+
+	if policy.HealthCheckEnabled {
+		rolledAt := time.Now()
+
+		var healthProbe *virtualMachineScaleSetHealthProbe
+		if metadata.HealthProbeID != "" {
+			probe, err := metadata.retrieveHealthProbe(ctx)
+			if err != nil {
+				return err
+			}
+			healthProbe = probe
+		}
+
+		// each batch is only given a limited amount of time to become healthy, so that a failing batch aborts
+		// the roll promptly rather than once the timeout for the whole update has elapsed
+		timeout := policy.HealthCheckTimeout
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < timeout {
+			timeout = time.Until(deadline)
+		}
+
+		log.Printf("[DEBUG] Waiting up to %s for Instances %q to become healthy..", timeout, strings.Join(instanceIds, ", "))
+		unhealthyInstanceIds := make([]string, 0)
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending:    []string{virtualMachineScaleSetInstanceHealthStateUnhealthy},
+			Target:     []string{virtualMachineScaleSetInstanceHealthStateHealthy},
+			Refresh:    metadata.instancesHealthRefreshFunc(ctx, instanceIds, healthProbe, rolledAt, &unhealthyInstanceIds),
+			MinTimeout: 15 * time.Second,
+			Timeout:    timeout,
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("waiting for Instances %q to become healthy (Unhealthy Instances: [%s]): %+v", strings.Join(instanceIds, ", "), strings.Join(unhealthyInstanceIds, ", "), err)
+		}
+		log.Printf("[DEBUG] Instances %q are healthy.", strings.Join(instanceIds, ", "))
 	}
 
-	log.Printf("[DEBUG] Rolled the VM Instances for %s Virtual Machine Scale Set %q (Resource Group %q).", metadata.OSType, id.Name, id.ResourceGroup)
 	return nil
 }
 
+// virtualMachineScaleSetHealthProbe is the Load Balancer Health Probe used to determine the health of each instance
+type virtualMachineScaleSetHealthProbe struct {
+	LoadBalancerID string
+
+	// the backend ports of the Load Balancing Rules which use this Health Probe
+	BackendPorts []string
+}
+
+func (metadata virtualMachineScaleSetUpdateMetaData) retrieveHealthProbe(ctx context.Context) (*virtualMachineScaleSetHealthProbe, error) {
+	probeId, err := loadBalancerParse.LoadBalancerProbeID(metadata.HealthProbeID)
+	if err != nil {
+		return nil, err
+	}
+
+	loadBalancer, err := metadata.LoadBalancersClient.Get(ctx, probeId.ResourceGroup, probeId.LoadBalancerName, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Load Balancer %q (Resource Group %q) for the Health Probe %q: %+v", probeId.LoadBalancerName, probeId.ResourceGroup, probeId.ProbeName, err)
+	}
+	if loadBalancer.ID == nil {
+		return nil, fmt.Errorf("retrieving Load Balancer %q (Resource Group %q): `id` was nil", probeId.LoadBalancerName, probeId.ResourceGroup)
+	}
+
+	ruleIds := make([]string, 0)
+	if props := loadBalancer.LoadBalancerPropertiesFormat; props != nil && props.Probes != nil {
+		for _, probe := range *props.Probes {
+			if probe.Name == nil || !strings.EqualFold(*probe.Name, probeId.ProbeName) || probe.ProbePropertiesFormat == nil || probe.LoadBalancingRules == nil {
+				continue
+			}
+
+			for _, rule := range *probe.LoadBalancingRules {
+				if rule.ID != nil {
+					ruleIds = append(ruleIds, *rule.ID)
+				}
+			}
+		}
+	}
+
+	backendPorts := make([]string, 0)
+	if props := loadBalancer.LoadBalancerPropertiesFormat; props != nil && props.LoadBalancingRules != nil {
+		for _, rule := range *props.LoadBalancingRules {
+			if rule.ID == nil || rule.LoadBalancingRulePropertiesFormat == nil || rule.BackendPort == nil {
+				continue
+			}
+
+			for _, ruleId := range ruleIds {
+				if strings.EqualFold(*rule.ID, ruleId) {
+					backendPorts = append(backendPorts, strconv.Itoa(int(*rule.BackendPort)))
+				}
+			}
+		}
+	}
+
+	return &virtualMachineScaleSetHealthProbe{
+		LoadBalancerID: *loadBalancer.ID,
+		BackendPorts:   backendPorts,
+	}, nil
+}
+
+func (metadata virtualMachineScaleSetUpdateMetaData) instancesHealthRefreshFunc(ctx context.Context, instanceIds []string, healthProbe *virtualMachineScaleSetHealthProbe, since time.Time, unhealthyInstanceIds *[]string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		unhealthy := make([]string, 0)
+		for _, instanceId := range instanceIds {
+			if healthProbe != nil {
+				state, err := metadata.instanceHealthStateFromHealthProbe(ctx, instanceId, *healthProbe, since)
+				if err != nil {
+					return nil, "", err
+				}
+
+				switch state {
+				case virtualMachineScaleSetInstanceHealthStateNotReported:
+					// the Health Probe Status metric is only available a short while after the instance has been rolled
+					unhealthy = append(unhealthy, fmt.Sprintf("%s (no health reported by the Load Balancer Health Probe)", instanceId))
+				case virtualMachineScaleSetInstanceHealthStateUnhealthy:
+					unhealthy = append(unhealthy, instanceId)
+				}
+				continue
+			}
+
+			resp, err := metadata.Client.VMScaleSetVMsClient.GetInstanceView(ctx, metadata.ID.ResourceGroup, metadata.ID.Name, instanceId)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving Instance View for Instance %q: %+v", instanceId, err)
+			}
+
+			switch virtualMachineScaleSetInstanceHealthState(resp.VMHealth) {
+			case virtualMachineScaleSetInstanceHealthStateNotReported:
+				// the health is only reported once the Application Health extension is running on the instance
+				unhealthy = append(unhealthy, fmt.Sprintf("%s (no health reported by the Application Health extension)", instanceId))
+			case virtualMachineScaleSetInstanceHealthStateUnhealthy:
+				unhealthy = append(unhealthy, instanceId)
+			}
+		}
+		*unhealthyInstanceIds = unhealthy
+
+		if len(unhealthy) > 0 {
+			return unhealthy, virtualMachineScaleSetInstanceHealthStateUnhealthy, nil
+		}
+
+		return unhealthy, virtualMachineScaleSetInstanceHealthStateHealthy, nil
+	}
+}
+
+// instanceHealthStateFromHealthProbe determines the health of an instance from the Health Probe Status metric of the
+// Load Balancer for each of the Private IP Addresses of the instance within a Backend Address Pool
+func (metadata virtualMachineScaleSetUpdateMetaData) instanceHealthStateFromHealthProbe(ctx context.Context, instanceId string, healthProbe virtualMachineScaleSetHealthProbe, since time.Time) (string, error) {
+	id := metadata.ID
+
+	interfaces, err := metadata.NetworkInterfacesClient.ListVirtualMachineScaleSetVMNetworkInterfacesComplete(ctx, id.ResourceGroup, id.Name, instanceId)
+	if err != nil {
+		return "", fmt.Errorf("listing Network Interfaces for Instance %q: %+v", instanceId, err)
+	}
+
+	ipAddresses := make([]string, 0)
+	for interfaces.NotDone() {
+		if props := interfaces.Value().InterfacePropertiesFormat; props != nil && props.IPConfigurations != nil {
+			for _, config := range *props.IPConfigurations {
+				if config.InterfaceIPConfigurationPropertiesFormat == nil || config.PrivateIPAddress == nil {
+					continue
+				}
+				if config.LoadBalancerBackendAddressPools == nil || len(*config.LoadBalancerBackendAddressPools) == 0 {
+					continue
+				}
+
+				ipAddresses = append(ipAddresses, *config.PrivateIPAddress)
+			}
+		}
+
+		if err := interfaces.NextWithContext(ctx); err != nil {
+			return "", fmt.Errorf("enumerating Network Interfaces for Instance %q: %+v", instanceId, err)
+		}
+	}
+
+	if len(ipAddresses) == 0 {
+		return virtualMachineScaleSetInstanceHealthStateNotReported, nil
+	}
+
+	timespan := fmt.Sprintf("%s/%s", since.UTC().Format(time.RFC3339), time.Now().UTC().Format(time.RFC3339))
+	state := virtualMachineScaleSetInstanceHealthStateHealthy
+	for _, ipAddress := range ipAddresses {
+		filter := fmt.Sprintf("BackendIPAddress eq '%s' and BackendPort eq '*'", ipAddress)
+		resp, err := metadata.MetricsClient.List(ctx, healthProbe.LoadBalancerID, timespan, utils.String("PT1M"), "DipAvailability", "Average", nil, "", filter, insights.ResultTypeData, "Microsoft.Network/loadBalancers")
+		if err != nil {
+			return "", fmt.Errorf("retrieving the Health Probe Status for Instance %q (IP Address %q): %+v", instanceId, ipAddress, err)
+		}
+
+		switch virtualMachineScaleSetInstanceHealthStateFromMetrics(resp.Value, healthProbe.BackendPorts) {
+		case virtualMachineScaleSetInstanceHealthStateUnhealthy:
+			return virtualMachineScaleSetInstanceHealthStateUnhealthy, nil
+		case virtualMachineScaleSetInstanceHealthStateNotReported:
+			state = virtualMachineScaleSetInstanceHealthStateNotReported
+		}
+	}
+
+	return state, nil
+}
+
+// determineFailedInstances returns the instances within the batch which either failed to provision or haven't had the
+// latest model applied, along with the reason for this where it's available - this is best-effort since we're already
+// returning an error at this point
+func (metadata virtualMachineScaleSetUpdateMetaData) determineFailedInstances(ctx context.Context, instanceIds []string) []string {
+	client := metadata.Client.VMScaleSetVMsClient
+	id := metadata.ID
+
+	failed := make([]string, 0)
+	for _, instanceId := range instanceIds {
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, instanceId, compute.InstanceViewTypesInstanceView)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s (unable to retrieve: %+v)", instanceId, err))
+			continue
+		}
+
+		props := resp.VirtualMachineScaleSetVMProperties
+		if props == nil {
+			continue
+		}
+
+		if props.InstanceView != nil && props.InstanceView.Statuses != nil {
+			if reason := virtualMachineScaleSetInstanceFailureReason(*props.InstanceView.Statuses); reason != "" {
+				failed = append(failed, fmt.Sprintf("%s (%s)", instanceId, reason))
+				continue
+			}
+		}
+
+		if props.LatestModelApplied == nil || !*props.LatestModelApplied {
+			failed = append(failed, fmt.Sprintf("%s (latest model not applied)", instanceId))
+		}
+	}
+
+	return failed
+}
+
+func virtualMachineScaleSetInstanceBatches(instanceIds []string, batchSize int) [][]string {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	batches := make([][]string, 0)
+	for i := 0; i < len(instanceIds); i += batchSize {
+		end := i + batchSize
+		if end > len(instanceIds) {
+			end = len(instanceIds)
+		}
+		batches = append(batches, instanceIds[i:end])
+	}
+
+	return batches
+}
+
+// virtualMachineScaleSetInstanceHealthState determines the health of an instance from the `vmHealth` field within the
+// Instance View, which is only populated when the Application Health extension is installed on the instance
+func virtualMachineScaleSetInstanceHealthState(input *compute.VirtualMachineHealthStatus) string {
+	if input == nil || input.Status == nil || input.Status.Code == nil {
+		return virtualMachineScaleSetInstanceHealthStateNotReported
+	}
+
+	if strings.EqualFold(*input.Status.Code, "HealthState/healthy") {
+		return virtualMachineScaleSetInstanceHealthStateHealthy
+	}
+
+	return virtualMachineScaleSetInstanceHealthStateUnhealthy
+}
+
+// virtualMachineScaleSetInstanceHealthStateFromMetrics determines the health of an instance from the most recent value
+// of the Health Probe Status (`DipAvailability`) metric for each of the backend ports used by the Health Probe, which
+// is reported as a percentage
+func virtualMachineScaleSetInstanceHealthStateFromMetrics(input *[]insights.Metric, backendPorts []string) string {
+	if input == nil {
+		return virtualMachineScaleSetInstanceHealthStateNotReported
+	}
+
+	reported := false
+	for _, metric := range *input {
+		if metric.Timeseries == nil {
+			continue
+		}
+
+		for _, timeseries := range *metric.Timeseries {
+			if !virtualMachineScaleSetTimeSeriesMatchesBackendPorts(timeseries, backendPorts) || timeseries.Data == nil {
+				continue
+			}
+
+			data := *timeseries.Data
+			for i := len(data) - 1; i >= 0; i-- {
+				if data[i].Average == nil {
+					continue
+				}
+
+				reported = true
+				if *data[i].Average < 100 {
+					return virtualMachineScaleSetInstanceHealthStateUnhealthy
+				}
+				break
+			}
+		}
+	}
+
+	if !reported {
+		return virtualMachineScaleSetInstanceHealthStateNotReported
+	}
+
+	return virtualMachineScaleSetInstanceHealthStateHealthy
+}
+
+func virtualMachineScaleSetTimeSeriesMatchesBackendPorts(input insights.TimeSeriesElement, backendPorts []string) bool {
+	if len(backendPorts) == 0 {
+		return true
+	}
+
+	if input.Metadatavalues == nil {
+		return false
+	}
+
+	for _, metadata := range *input.Metadatavalues {
+		if metadata.Name == nil || metadata.Name.Value == nil || !strings.EqualFold(*metadata.Name.Value, "BackendPort") || metadata.Value == nil {
+			continue
+		}
+
+		for _, port := range backendPorts {
+			if *metadata.Value == port {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func virtualMachineScaleSetInstanceFailureReason(statuses []compute.InstanceViewStatus) string {
+	for _, status := range statuses {
+		if status.Code == nil || !strings.HasPrefix(strings.ToLower(*status.Code), "provisioningstate/failed") {
+			continue
+		}
+
+		if status.Message != nil && *status.Message != "" {
+			return *status.Message
+		}
+
+		return *status.Code
+	}
+
+	return ""
+}
+
 func isUsingLatestImage(update compute.VirtualMachineScaleSetUpdate) bool {
 	if update.VirtualMachineProfile.StorageProfile == nil ||
 		update.VirtualMachineProfile.StorageProfile.ImageReference == nil ||
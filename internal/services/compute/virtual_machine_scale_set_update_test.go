@@ -0,0 +1,335 @@
+package compute
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestVirtualMachineScaleSetInstanceBatches(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Input     []string
+		BatchSize int
+		Expected  [][]string
+	}{
+		{
+			Name:      "No Instances",
+			Input:     []string{},
+			BatchSize: 2,
+			Expected:  [][]string{},
+		},
+		{
+			Name:      "Single Instance Batches",
+			Input:     []string{"0", "1", "2"},
+			BatchSize: 1,
+			Expected:  [][]string{{"0"}, {"1"}, {"2"}},
+		},
+		{
+			Name:      "Evenly Divisible",
+			Input:     []string{"0", "1", "2", "3"},
+			BatchSize: 2,
+			Expected:  [][]string{{"0", "1"}, {"2", "3"}},
+		},
+		{
+			Name:      "Partial Final Batch",
+			Input:     []string{"0", "1", "2", "3", "4"},
+			BatchSize: 2,
+			Expected:  [][]string{{"0", "1"}, {"2", "3"}, {"4"}},
+		},
+		{
+			Name:      "Batch Larger Than Instances",
+			Input:     []string{"0", "1"},
+			BatchSize: 5,
+			Expected:  [][]string{{"0", "1"}},
+		},
+		{
+			Name:      "Invalid Batch Size",
+			Input:     []string{"0", "1"},
+			BatchSize: 0,
+			Expected:  [][]string{{"0"}, {"1"}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("[DEBUG] Testing %q..", testCase.Name)
+
+		actual := virtualMachineScaleSetInstanceBatches(testCase.Input, testCase.BatchSize)
+		if !reflect.DeepEqual(actual, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, actual)
+		}
+	}
+}
+
+func TestVirtualMachineScaleSetInstanceHealthState(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *compute.VirtualMachineHealthStatus
+		Expected string
+	}{
+		{
+			// the Application Health extension isn't installed/hasn't reported yet
+			Name:     "No VM Health",
+			Input:    nil,
+			Expected: virtualMachineScaleSetInstanceHealthStateNotReported,
+		},
+		{
+			Name: "No Status",
+			Input: &compute.VirtualMachineHealthStatus{
+				Status: nil,
+			},
+			Expected: virtualMachineScaleSetInstanceHealthStateNotReported,
+		},
+		{
+			Name: "Healthy",
+			Input: &compute.VirtualMachineHealthStatus{
+				Status: &compute.InstanceViewStatus{
+					Code: utils.String("HealthState/healthy"),
+				},
+			},
+			Expected: virtualMachineScaleSetInstanceHealthStateHealthy,
+		},
+		{
+			Name: "Unhealthy",
+			Input: &compute.VirtualMachineHealthStatus{
+				Status: &compute.InstanceViewStatus{
+					Code: utils.String("HealthState/unhealthy"),
+				},
+			},
+			Expected: virtualMachineScaleSetInstanceHealthStateUnhealthy,
+		},
+		{
+			Name: "Unknown",
+			Input: &compute.VirtualMachineHealthStatus{
+				Status: &compute.InstanceViewStatus{
+					Code: utils.String("HealthState/unknown"),
+				},
+			},
+			Expected: virtualMachineScaleSetInstanceHealthStateUnhealthy,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("[DEBUG] Testing %q..", testCase.Name)
+
+		actual := virtualMachineScaleSetInstanceHealthState(testCase.Input)
+		if actual != testCase.Expected {
+			t.Fatalf("Expected %q but got %q", testCase.Expected, actual)
+		}
+	}
+}
+
+func TestVirtualMachineScaleSetInstanceFailureReason(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    []compute.InstanceViewStatus
+		Expected string
+	}{
+		{
+			Name:     "None",
+			Input:    []compute.InstanceViewStatus{},
+			Expected: "",
+		},
+		{
+			Name: "Succeeded",
+			Input: []compute.InstanceViewStatus{
+				{Code: utils.String("ProvisioningState/succeeded")},
+				{Code: utils.String("PowerState/running")},
+			},
+			Expected: "",
+		},
+		{
+			Name: "Failed With Message",
+			Input: []compute.InstanceViewStatus{
+				{
+					Code:    utils.String("ProvisioningState/failed/VMExtensionProvisioningError"),
+					Message: utils.String("VM has reported a failure when processing extension 'example'."),
+				},
+				{Code: utils.String("PowerState/running")},
+			},
+			Expected: "VM has reported a failure when processing extension 'example'.",
+		},
+		{
+			Name: "Failed Without Message",
+			Input: []compute.InstanceViewStatus{
+				{Code: utils.String("ProvisioningState/failed/InternalExecutionError")},
+			},
+			Expected: "ProvisioningState/failed/InternalExecutionError",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("[DEBUG] Testing %q..", testCase.Name)
+
+		actual := virtualMachineScaleSetInstanceFailureReason(testCase.Input)
+		if actual != testCase.Expected {
+			t.Fatalf("Expected %q but got %q", testCase.Expected, actual)
+		}
+	}
+}
+
+func TestValidateVirtualMachineScaleSetInstanceUpgradePolicy(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		UpgradeMode        compute.UpgradeMode
+		HealthProbeId      string
+		HasHealthExtension bool
+		HealthCheckEnabled bool
+		ExpectError        bool
+	}{
+		{
+			Name:        "Manual",
+			UpgradeMode: compute.UpgradeModeManual,
+			ExpectError: false,
+		},
+		{
+			Name:        "Automatic",
+			UpgradeMode: compute.UpgradeModeAutomatic,
+			ExpectError: true,
+		},
+		{
+			Name:               "Health Check with the Application Health Extension",
+			UpgradeMode:        compute.UpgradeModeManual,
+			HasHealthExtension: true,
+			HealthCheckEnabled: true,
+			ExpectError:        false,
+		},
+		{
+			Name:               "Health Check with a Health Probe",
+			UpgradeMode:        compute.UpgradeModeManual,
+			HealthProbeId:      "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/lb1/probes/probe1",
+			HasHealthExtension: false,
+			HealthCheckEnabled: true,
+			ExpectError:        false,
+		},
+		{
+			Name:               "Health Check without a Health Probe or the Application Health Extension",
+			UpgradeMode:        compute.UpgradeModeManual,
+			HasHealthExtension: false,
+			HealthCheckEnabled: true,
+			ExpectError:        true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("[DEBUG] Testing %q..", testCase.Name)
+
+		input := []interface{}{
+			map[string]interface{}{
+				"health_check_enabled": testCase.HealthCheckEnabled,
+			},
+		}
+		err := validateVirtualMachineScaleSetInstanceUpgradePolicy(testCase.UpgradeMode, testCase.HealthProbeId, testCase.HasHealthExtension, input)
+		if testCase.ExpectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !testCase.ExpectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+}
+
+func TestVirtualMachineScaleSetInstanceHealthStateFromMetrics(t *testing.T) {
+	timeseries := func(port string, values ...*float64) insights.TimeSeriesElement {
+		data := make([]insights.MetricValue, 0)
+		for _, v := range values {
+			data = append(data, insights.MetricValue{
+				Average: v,
+			})
+		}
+		return insights.TimeSeriesElement{
+			Metadatavalues: &[]insights.MetadataValue{
+				{
+					Name: &insights.LocalizableString{
+						Value: utils.String("backendport"),
+					},
+					Value: utils.String(port),
+				},
+			},
+			Data: &data,
+		}
+	}
+
+	testCases := []struct {
+		Name         string
+		Input        *[]insights.TimeSeriesElement
+		BackendPorts []string
+		Expected     string
+	}{
+		{
+			Name:     "No Metrics",
+			Input:    nil,
+			Expected: virtualMachineScaleSetInstanceHealthStateNotReported,
+		},
+		{
+			Name: "No Data Points",
+			Input: &[]insights.TimeSeriesElement{
+				timeseries("80", nil, nil),
+			},
+			BackendPorts: []string{"80"},
+			Expected:     virtualMachineScaleSetInstanceHealthStateNotReported,
+		},
+		{
+			Name: "Healthy",
+			Input: &[]insights.TimeSeriesElement{
+				timeseries("80", utils.Float(0), utils.Float(100), nil),
+			},
+			BackendPorts: []string{"80"},
+			Expected:     virtualMachineScaleSetInstanceHealthStateHealthy,
+		},
+		{
+			Name: "Unhealthy",
+			Input: &[]insights.TimeSeriesElement{
+				timeseries("80", utils.Float(100), utils.Float(50)),
+			},
+			BackendPorts: []string{"80"},
+			Expected:     virtualMachineScaleSetInstanceHealthStateUnhealthy,
+		},
+		{
+			Name: "Unhealthy on another Backend Port",
+			Input: &[]insights.TimeSeriesElement{
+				timeseries("80", utils.Float(100)),
+				timeseries("443", utils.Float(0)),
+			},
+			BackendPorts: []string{"80"},
+			Expected:     virtualMachineScaleSetInstanceHealthStateHealthy,
+		},
+		{
+			Name: "Unhealthy on one of the Backend Ports",
+			Input: &[]insights.TimeSeriesElement{
+				timeseries("80", utils.Float(100)),
+				timeseries("443", utils.Float(0)),
+			},
+			BackendPorts: []string{"80", "443"},
+			Expected:     virtualMachineScaleSetInstanceHealthStateUnhealthy,
+		},
+		{
+			Name: "Probe not used by a Load Balancing Rule",
+			Input: &[]insights.TimeSeriesElement{
+				timeseries("80", utils.Float(100)),
+			},
+			BackendPorts: []string{},
+			Expected:     virtualMachineScaleSetInstanceHealthStateHealthy,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("[DEBUG] Testing %q..", testCase.Name)
+
+		var input *[]insights.Metric
+		if testCase.Input != nil {
+			input = &[]insights.Metric{
+				{
+					Timeseries: testCase.Input,
+				},
+			}
+		}
+		actual := virtualMachineScaleSetInstanceHealthStateFromMetrics(input, testCase.BackendPorts)
+		if actual != testCase.Expected {
+			t.Fatalf("Expected %q but got %q", testCase.Expected, actual)
+		}
+	}
+}
@@ -121,6 +121,16 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 
 			"boot_diagnostics": bootDiagnosticsSchema(),
 
+			"capacity_reservation_group_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: computeValidate.CapacityReservationGroupID,
+				ConflictsWith: []string{
+					"availability_set_id",
+					"proximity_placement_group_id",
+				},
+			},
+
 			"computer_name": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -174,6 +184,8 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 				ValidateFunc: azValidate.ISO8601DurationBetween("PT15M", "PT2H"),
 			},
 
+			"gallery_application": galleryApplicationSchema(false),
+
 			"identity": virtualMachineIdentity{}.Schema(),
 
 			"license_type": {
@@ -214,6 +226,8 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 
 			"plan": planSchema(),
 
+			"power_state": virtualMachinePowerStateSchema(),
+
 			"priority": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -337,6 +351,8 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(virtualMachineEvictedSpotInstanceCustomizeDiff),
 	}
 }
 
@@ -545,6 +561,12 @@ func resourceWindowsVirtualMachineCreate(d *pluginsdk.ResourceData, meta interfa
 		params.LicenseType = utils.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("gallery_application"); ok {
+		params.ApplicationProfile = &compute.ApplicationProfile{
+			GalleryApplications: expandGalleryApplications(v.([]interface{})),
+		}
+	}
+
 	if v, ok := d.Get("max_bid_price").(float64); ok && v > 0 {
 		if priority != compute.VirtualMachinePriorityTypesSpot {
 			return fmt.Errorf("`max_bid_price` can only be configured when `priority` is set to `Spot`")
@@ -561,6 +583,14 @@ func resourceWindowsVirtualMachineCreate(d *pluginsdk.ResourceData, meta interfa
 		}
 	}
 
+	if v, ok := d.GetOk("capacity_reservation_group_id"); ok {
+		params.CapacityReservation = &compute.CapacityReservationProfile{
+			CapacityReservationGroup: &compute.SubResource{
+				ID: utils.String(v.(string)),
+			},
+		}
+	}
+
 	if v, ok := d.GetOk("virtual_machine_scale_set_id"); ok {
 		params.VirtualMachineScaleSet = &compute.SubResource{
 			ID: utils.String(v.(string)),
@@ -601,6 +631,14 @@ func resourceWindowsVirtualMachineCreate(d *pluginsdk.ResourceData, meta interfa
 	}
 
 	d.SetId(*read.ID)
+
+	// Virtual Machines are running once they've been provisioned
+	if powerState := d.Get("power_state").(string); powerState != "" && powerState != virtualMachinePowerStateRunning {
+		if err := updateVirtualMachinePowerState(ctx, client, resourceGroup, name, powerState, meta.(*clients.Client).Features.VirtualMachine.GracefulShutdown); err != nil {
+			return err
+		}
+	}
+
 	return resourceWindowsVirtualMachineRead(d, meta)
 }
 
@@ -665,6 +703,10 @@ func resourceWindowsVirtualMachineRead(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("setting `boot_diagnostics`: %+v", err)
 	}
 
+	if err := d.Set("gallery_application", flattenGalleryApplications(props.ApplicationProfile)); err != nil {
+		return fmt.Errorf("setting `gallery_application`: %+v", err)
+	}
+
 	d.Set("eviction_policy", string(props.EvictionPolicy))
 	if profile := props.HardwareProfile; profile != nil {
 		d.Set("size", string(profile.VMSize))
@@ -696,6 +738,12 @@ func resourceWindowsVirtualMachineRead(d *pluginsdk.ResourceData, meta interface
 	}
 	d.Set("dedicated_host_id", dedicatedHostId)
 
+	capacityReservationGroupId := ""
+	if props.CapacityReservation != nil && props.CapacityReservation.CapacityReservationGroup != nil && props.CapacityReservation.CapacityReservationGroup.ID != nil {
+		capacityReservationGroupId = *props.CapacityReservation.CapacityReservationGroup.ID
+	}
+	d.Set("capacity_reservation_group_id", capacityReservationGroupId)
+
 	virtualMachineScaleSetId := ""
 	if props.VirtualMachineScaleSet != nil && props.VirtualMachineScaleSet.ID != nil {
 		virtualMachineScaleSetId = *props.VirtualMachineScaleSet.ID
@@ -794,6 +842,12 @@ func resourceWindowsVirtualMachineRead(d *pluginsdk.ResourceData, meta interface
 
 	d.Set("virtual_machine_id", props.VMID)
 
+	instanceView, err := client.InstanceView(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving InstanceView for Windows Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+	d.Set("power_state", virtualMachinePowerState(instanceView))
+
 	zone := ""
 	if resp.Zones != nil {
 		if zones := *resp.Zones; len(zones) > 0 {
@@ -869,6 +923,15 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 		update.VirtualMachineProperties.DiagnosticsProfile = expandBootDiagnostics(bootDiagnosticsRaw)
 	}
 
+	if d.HasChange("gallery_application") {
+		shouldUpdate = true
+
+		// an empty list is sent to remove all of the Gallery Applications from the Virtual Machine
+		update.VirtualMachineProperties.ApplicationProfile = &compute.ApplicationProfile{
+			GalleryApplications: expandGalleryApplications(d.Get("gallery_application").([]interface{})),
+		}
+	}
+
 	if d.HasChange("secret") {
 		shouldUpdate = true
 
@@ -1006,6 +1069,27 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 		}
 	}
 
+	if d.HasChange("capacity_reservation_group_id") {
+		shouldUpdate = true
+
+		// the Capacity Reservation Group can only be changed whilst the Virtual Machine is deallocated
+		shouldShutDown = true
+		shouldDeallocate = true
+
+		if v, ok := d.GetOk("capacity_reservation_group_id"); ok {
+			update.VirtualMachineProperties.CapacityReservation = &compute.CapacityReservationProfile{
+				CapacityReservationGroup: &compute.SubResource{
+					ID: utils.String(v.(string)),
+				},
+			}
+		} else {
+			// an empty Capacity Reservation Group dissociates the Virtual Machine from the Capacity Reservation Group
+			update.VirtualMachineProperties.CapacityReservation = &compute.CapacityReservationProfile{
+				CapacityReservationGroup: &compute.SubResource{},
+			}
+		}
+	}
+
 	if d.HasChange("size") {
 		shouldUpdate = true
 
@@ -1234,8 +1318,8 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 		log.Printf("[DEBUG] Updated Windows Virtual Machine %q (Resource Group %q).", id.Name, id.ResourceGroup)
 	}
 
-	// if we've shut it down and it was turned off, let's boot it back up
-	if shouldTurnBackOn && shouldShutDown {
+	// if we've shut it down and it was turned off, let's boot it back up - unless the `power_state` is changing
+	if shouldTurnBackOn && shouldShutDown && !d.HasChange("power_state") {
 		log.Printf("[DEBUG] Starting Windows Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
 		future, err := client.Start(ctx, id.ResourceGroup, id.Name)
 		if err != nil {
@@ -1249,6 +1333,13 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 		log.Printf("[DEBUG] Started Windows Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
 	}
 
+	// the `power_state` has either been changed in the config or by `virtualMachineEvictedSpotInstanceCustomizeDiff`
+	if d.HasChange("power_state") {
+		if err := updateVirtualMachinePowerState(ctx, client, id.ResourceGroup, id.Name, d.Get("power_state").(string), meta.(*clients.Client).Features.VirtualMachine.GracefulShutdown); err != nil {
+			return err
+		}
+	}
+
 	return resourceWindowsVirtualMachineRead(d, meta)
 }
 
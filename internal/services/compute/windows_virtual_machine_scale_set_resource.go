@@ -99,6 +99,17 @@ func resourceWindowsVirtualMachineScaleSet() *pluginsdk.Resource {
 
 			"boot_diagnostics": bootDiagnosticsSchema(),
 
+			// the Virtual Machine Scale Set Update API doesn't support updating the Capacity Reservation Profile
+			"capacity_reservation_group_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: computeValidate.CapacityReservationGroupID,
+				ConflictsWith: []string{
+					"proximity_placement_group_id",
+				},
+			},
+
 			"computer_name_prefix": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -151,6 +162,9 @@ func resourceWindowsVirtualMachineScaleSet() *pluginsdk.Resource {
 				ValidateFunc: validate.ISO8601DurationBetween("PT15M", "PT2H"),
 			},
 
+			// the Virtual Machine Scale Set Update API doesn't support updating the Application Profile
+			"gallery_application": galleryApplicationSchema(true),
+
 			"health_probe_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -159,6 +173,9 @@ func resourceWindowsVirtualMachineScaleSet() *pluginsdk.Resource {
 
 			"identity": VirtualMachineScaleSetIdentitySchema(),
 
+			// this is only used by Terraform when rolling the instances in a Scale Set with a `Manual` upgrade mode
+			"instance_upgrade_policy": VirtualMachineScaleSetInstanceUpgradePolicySchema(),
+
 			"license_type": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -383,6 +400,11 @@ func resourceWindowsVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData, meta
 		return fmt.Errorf("an `automatic_os_upgrade_policy` block cannot be specified when `upgrade_mode` is not set to `Automatic`")
 	}
 
+	hasHealthExtension := virtualMachineScaleSetHasHealthExtension(d.Get("extension").(*pluginsdk.Set).List())
+	if err := validateVirtualMachineScaleSetInstanceUpgradePolicy(upgradeMode, d.Get("health_probe_id").(string), hasHealthExtension, d.Get("instance_upgrade_policy").([]interface{})); err != nil {
+		return err
+	}
+
 	shouldHaveRollingUpgradePolicy := upgradeMode == compute.UpgradeModeAutomatic || upgradeMode == compute.UpgradeModeRolling
 	if !shouldHaveRollingUpgradePolicy && len(rollingUpgradePolicyRaw) > 0 {
 		return fmt.Errorf("a `rolling_upgrade_policy` block cannot be specified when `upgrade_mode` is set to %q", string(upgradeMode))
@@ -449,7 +471,12 @@ func resourceWindowsVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData, meta
 		},
 	}
 
-	hasHealthExtension := false
+	if v, ok := d.GetOk("gallery_application"); ok {
+		virtualMachineProfile.ApplicationProfile = &compute.ApplicationProfile{
+			GalleryApplications: expandGalleryApplications(v.([]interface{})),
+		}
+	}
+
 	if vmExtensionsRaw, ok := d.GetOk("extension"); ok {
 		virtualMachineProfile.ExtensionProfile, hasHealthExtension, err = expandVirtualMachineScaleSetExtensions(vmExtensionsRaw.(*pluginsdk.Set).List())
 		if err != nil {
@@ -595,6 +622,18 @@ func resourceWindowsVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData, meta
 		}
 	}
 
+	if v, ok := d.GetOk("capacity_reservation_group_id"); ok {
+		if d.Get("single_placement_group").(bool) {
+			return fmt.Errorf("`single_placement_group` must be set to `false` when `capacity_reservation_group_id` is specified")
+		}
+
+		props.VirtualMachineScaleSetProperties.VirtualMachineProfile.CapacityReservation = &compute.CapacityReservationProfile{
+			CapacityReservationGroup: &compute.SubResource{
+				ID: utils.String(v.(string)),
+			},
+		}
+	}
+
 	if v, ok := d.GetOk("zone_balance"); ok && v.(bool) {
 		if len(zonesRaw) == 0 {
 			return fmt.Errorf("`zone_balance` can only be set to `true` when zones are specified")
@@ -933,14 +972,29 @@ func resourceWindowsVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData, meta
 
 	update.VirtualMachineScaleSetUpdateProperties = &updateProps
 
+	instanceUpgradePolicyRaw := d.Get("instance_upgrade_policy").([]interface{})
+	hasHealthExtension := virtualMachineScaleSetHasHealthExtension(d.Get("extension").(*pluginsdk.Set).List())
+	if err := validateVirtualMachineScaleSetInstanceUpgradePolicy(compute.UpgradeMode(d.Get("upgrade_mode").(string)), d.Get("health_probe_id").(string), hasHealthExtension, instanceUpgradePolicyRaw); err != nil {
+		return err
+	}
+	instanceUpgradePolicy, err := expandVirtualMachineScaleSetInstanceUpgradePolicy(instanceUpgradePolicyRaw)
+	if err != nil {
+		return fmt.Errorf("expanding `instance_upgrade_policy`: %+v", err)
+	}
+
 	metaData := virtualMachineScaleSetUpdateMetaData{
 		AutomaticOSUpgradeIsEnabled:  automaticOSUpgradeIsEnabled,
 		CanRollInstancesWhenRequired: meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesWhenRequired,
 		UpdateInstances:              updateInstances,
+		InstanceUpgradePolicy:        instanceUpgradePolicy,
+		HealthProbeID:                d.Get("health_probe_id").(string),
 		Client:                       meta.(*clients.Client).Compute,
 		Existing:                     existing,
 		ID:                           id,
 		OSType:                       compute.OperatingSystemTypesWindows,
+		LoadBalancersClient:          meta.(*clients.Client).LoadBalancers.LoadBalancersClient,
+		MetricsClient:                meta.(*clients.Client).Monitor.MetricsClient,
+		NetworkInterfacesClient:      meta.(*clients.Client).Network.InterfacesClient,
 	}
 
 	if err := metaData.performUpdate(ctx, update); err != nil {
@@ -1055,6 +1109,16 @@ func resourceWindowsVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta i
 			return fmt.Errorf("setting `boot_diagnostics`: %+v", err)
 		}
 
+		capacityReservationGroupId := ""
+		if profile.CapacityReservation != nil && profile.CapacityReservation.CapacityReservationGroup != nil && profile.CapacityReservation.CapacityReservationGroup.ID != nil {
+			capacityReservationGroupId = *profile.CapacityReservation.CapacityReservationGroup.ID
+		}
+		d.Set("capacity_reservation_group_id", capacityReservationGroupId)
+
+		if err := d.Set("gallery_application", flattenGalleryApplications(profile.ApplicationProfile)); err != nil {
+			return fmt.Errorf("setting `gallery_application`: %+v", err)
+		}
+
 		// defaulted since BillingProfile isn't returned if it's unset
 		maxBidPrice := float64(-1.0)
 		if profile.BillingProfile != nil && profile.BillingProfile.MaxPrice != nil {
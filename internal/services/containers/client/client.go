@@ -3,18 +3,27 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/containerinstance/mgmt/2019-12-01/containerinstance"
 	legacy "github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2019-08-01/containerservice"
-	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-08-01/containerservice"
 	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
+	"github.com/Azure/azure-sdk-for-go/services/preview/containerservice/mgmt/2022-03-02-preview/containerservice"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2022-03-01/extensions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2022-03-01/fluxconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-01-01-preview/cacherules"
 )
 
 type Client struct {
 	AgentPoolsClient                *containerservice.AgentPoolsClient
+	CacheRulesClient                *cacherules.CacheRulesClient
+	ExtensionsClient                *extensions.ExtensionsClient
+	FluxConfigurationClient         *fluxconfiguration.FluxConfigurationClient
 	GroupsClient                    *containerinstance.ContainerGroupsClient
 	KubernetesClustersClient        *containerservice.ManagedClustersClient
 	MaintenanceConfigurationsClient *containerservice.MaintenanceConfigurationsClient
 	RegistriesClient                *containerregistry.RegistriesClient
+	RegistryAgentPoolsClient        *containerregistry.AgentPoolsClient
+	RegistryRunsClient              *containerregistry.RunsClient
+	RegistryTasksClient             *containerregistry.TasksClient
 	ReplicationsClient              *containerregistry.ReplicationsClient
 	ServicesClient                  *legacy.ContainerServicesClient
 	WebhooksClient                  *containerregistry.WebhooksClient
@@ -40,6 +49,18 @@ func NewClient(o *common.ClientOptions) *Client {
 	scopeMapsClient := containerregistry.NewScopeMapsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&scopeMapsClient.Client, o.ResourceManagerAuthorizer)
 
+	registryTasksClient := containerregistry.NewTasksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&registryTasksClient.Client, o.ResourceManagerAuthorizer)
+
+	registryRunsClient := containerregistry.NewRunsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&registryRunsClient.Client, o.ResourceManagerAuthorizer)
+
+	registryAgentPoolsClient := containerregistry.NewAgentPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&registryAgentPoolsClient.Client, o.ResourceManagerAuthorizer)
+
+	cacheRulesClient := cacherules.NewCacheRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&cacheRulesClient.Client, o.ResourceManagerAuthorizer)
+
 	groupsClient := containerinstance.NewContainerGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&groupsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -53,15 +74,28 @@ func NewClient(o *common.ClientOptions) *Client {
 	maintenanceConfigurationsClient := containerservice.NewMaintenanceConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&maintenanceConfigurationsClient.Client, o.ResourceManagerAuthorizer)
 
+	// Kubernetes Configuration
+	extensionsClient := extensions.NewExtensionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&extensionsClient.Client, o.ResourceManagerAuthorizer)
+
+	fluxConfigurationClient := fluxconfiguration.NewFluxConfigurationClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fluxConfigurationClient.Client, o.ResourceManagerAuthorizer)
+
 	servicesClient := legacy.NewContainerServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&servicesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
 		AgentPoolsClient:                &agentPoolsClient,
+		CacheRulesClient:                &cacheRulesClient,
 		KubernetesClustersClient:        &kubernetesClustersClient,
+		ExtensionsClient:                &extensionsClient,
+		FluxConfigurationClient:         &fluxConfigurationClient,
 		GroupsClient:                    &groupsClient,
 		MaintenanceConfigurationsClient: &maintenanceConfigurationsClient,
 		RegistriesClient:                &registriesClient,
+		RegistryAgentPoolsClient:        &registryAgentPoolsClient,
+		RegistryRunsClient:              &registryRunsClient,
+		RegistryTasksClient:             &registryTasksClient,
 		WebhooksClient:                  &webhooksClient,
 		ReplicationsClient:              &replicationsClient,
 		ServicesClient:                  &servicesClient,
@@ -0,0 +1,229 @@
+package containers
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceContainerRegistryAgentPool() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceContainerRegistryAgentPoolCreate,
+		Read:   resourceContainerRegistryAgentPoolRead,
+		Update: resourceContainerRegistryAgentPoolUpdate,
+		Delete: resourceContainerRegistryAgentPoolDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ContainerRegistryAgentPoolID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ContainerRegistryAgentPoolName,
+			},
+
+			"container_registry_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RegistryID,
+			},
+
+			"instance_count": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"tier": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "S1",
+				ValidateFunc: validation.StringInSlice([]string{
+					"S1",
+					"S2",
+					"S3",
+					"I6",
+				}, false),
+			},
+
+			"virtual_network_subnet_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.SubnetID,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceContainerRegistryAgentPoolCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.RegistryAgentPoolsClient
+	registriesClient := meta.(*clients.Client).Containers.RegistriesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	registryId, err := parse.RegistryID(d.Get("container_registry_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewContainerRegistryAgentPoolID(registryId.SubscriptionId, registryId.ResourceGroup, registryId.Name, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.AgentPoolName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_container_registry_agent_pool", id.ID())
+	}
+
+	// the Agent Pool must be in the same location as the Container Registry
+	registry, err := registriesClient.Get(ctx, registryId.ResourceGroup, registryId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *registryId, err)
+	}
+
+	parameters := containerregistry.AgentPool{
+		Location: registry.Location,
+		AgentPoolProperties: &containerregistry.AgentPoolProperties{
+			Count: utils.Int32(int32(d.Get("instance_count").(int))),
+			Tier:  utils.String(d.Get("tier").(string)),
+			Os:    containerregistry.Linux,
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v := d.Get("virtual_network_subnet_id").(string); v != "" {
+		parameters.AgentPoolProperties.VirtualNetworkSubnetResourceID = utils.String(v)
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.RegistryName, id.AgentPoolName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceContainerRegistryAgentPoolRead(d, meta)
+}
+
+func resourceContainerRegistryAgentPoolUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.RegistryAgentPoolsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ContainerRegistryAgentPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := containerregistry.AgentPoolUpdateParameters{
+		AgentPoolPropertiesUpdateParameters: &containerregistry.AgentPoolPropertiesUpdateParameters{
+			Count: utils.Int32(int32(d.Get("instance_count").(int))),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.RegistryName, id.AgentPoolName, parameters)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+	}
+
+	return resourceContainerRegistryAgentPoolRead(d, meta)
+}
+
+func resourceContainerRegistryAgentPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.RegistryAgentPoolsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ContainerRegistryAgentPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.AgentPoolName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.AgentPoolName)
+	d.Set("container_registry_id", parse.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.RegistryName).ID())
+
+	if props := resp.AgentPoolProperties; props != nil {
+		count := 0
+		if props.Count != nil {
+			count = int(*props.Count)
+		}
+		d.Set("instance_count", count)
+		d.Set("tier", props.Tier)
+		d.Set("virtual_network_subnet_id", props.VirtualNetworkSubnetResourceID)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceContainerRegistryAgentPoolDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.RegistryAgentPoolsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ContainerRegistryAgentPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.RegistryName, id.AgentPoolName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerRegistryAgentPoolResource struct{}
+
+func TestAccContainerRegistryAgentPool_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_agent_pool", "test")
+	r := ContainerRegistryAgentPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryAgentPool_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_agent_pool", "test")
+	r := ContainerRegistryAgentPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerRegistryAgentPool_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_agent_pool", "test")
+	r := ContainerRegistryAgentPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_count").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_count").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ContainerRegistryAgentPoolResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ContainerRegistryAgentPoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.RegistryAgentPoolsClient.Get(ctx, id.ResourceGroup, id.RegistryName, id.AgentPoolName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.AgentPoolProperties != nil), nil
+}
+
+func (r ContainerRegistryAgentPoolResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccr%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Premium"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r ContainerRegistryAgentPoolResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_agent_pool" "test" {
+  name                  = "acctest%d"
+  container_registry_id = azurerm_container_registry.test.id
+}
+`, r.template(data), data.RandomIntOfLength(8))
+}
+
+func (r ContainerRegistryAgentPoolResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_agent_pool" "import" {
+  name                  = azurerm_container_registry_agent_pool.test.name
+  container_registry_id = azurerm_container_registry_agent_pool.test.container_registry_id
+}
+`, r.basic(data))
+}
+
+func (r ContainerRegistryAgentPoolResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_agent_pool" "test" {
+  name                  = "acctest%d"
+  container_registry_id = azurerm_container_registry.test.id
+  instance_count        = 2
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomIntOfLength(8))
+}
@@ -0,0 +1,192 @@
+package containers
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-01-01-preview/cacherules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceContainerRegistryCacheRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceContainerRegistryCacheRuleCreate,
+		Read:   resourceContainerRegistryCacheRuleRead,
+		Update: resourceContainerRegistryCacheRuleUpdate,
+		Delete: resourceContainerRegistryCacheRuleDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := cacherules.ParseCacheRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ContainerRegistryCacheRuleName,
+			},
+
+			"container_registry_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RegistryID,
+			},
+
+			"source_repo": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"target_repo": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"credential_set_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+func resourceContainerRegistryCacheRuleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.CacheRulesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	registryId, err := parse.RegistryID(d.Get("container_registry_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := cacherules.NewCacheRuleID(registryId.SubscriptionId, registryId.ResourceGroup, registryId.Name, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_container_registry_cache_rule", id.ID())
+	}
+
+	parameters := cacherules.CacheRule{
+		Properties: &cacherules.CacheRuleProperties{
+			SourceRepository: utils.String(d.Get("source_repo").(string)),
+			TargetRepository: utils.String(d.Get("target_repo").(string)),
+		},
+	}
+
+	if v := d.Get("credential_set_id").(string); v != "" {
+		parameters.Properties.CredentialSetResourceId = utils.String(v)
+	}
+
+	if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceContainerRegistryCacheRuleRead(d, meta)
+}
+
+func resourceContainerRegistryCacheRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.CacheRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := cacherules.ParseCacheRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.CacheRuleName)
+	d.Set("container_registry_id", parse.NewRegistryID(id.SubscriptionId, id.ResourceGroupName, id.RegistryName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("source_repo", props.SourceRepository)
+			d.Set("target_repo", props.TargetRepository)
+			d.Set("credential_set_id", props.CredentialSetResourceId)
+		}
+	}
+
+	return nil
+}
+
+func resourceContainerRegistryCacheRuleUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.CacheRulesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := cacherules.ParseCacheRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := cacherules.CacheRuleUpdateParameters{
+		Properties: &cacherules.CacheRuleUpdateProperties{},
+	}
+
+	if v := d.Get("credential_set_id").(string); v != "" {
+		parameters.Properties.CredentialSetResourceId = utils.String(v)
+	}
+
+	if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceContainerRegistryCacheRuleRead(d, meta)
+}
+
+func resourceContainerRegistryCacheRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.CacheRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := cacherules.ParseCacheRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
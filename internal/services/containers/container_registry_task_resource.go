@@ -0,0 +1,1357 @@
+package containers
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	identityValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceContainerRegistryTask() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceContainerRegistryTaskCreateUpdate,
+		Read:   resourceContainerRegistryTaskRead,
+		Update: resourceContainerRegistryTaskCreateUpdate,
+		Delete: resourceContainerRegistryTaskDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ContainerRegistryTaskID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ContainerRegistryTaskName,
+			},
+
+			"container_registry_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RegistryID,
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"platform": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"os": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerregistry.Linux),
+								string(containerregistry.Windows),
+							}, false),
+						},
+
+						"architecture": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerregistry.Amd64),
+								string(containerregistry.Arm),
+								string(containerregistry.Arm64),
+								string(containerregistry.ThreeEightSix),
+								string(containerregistry.X86),
+							}, false),
+						},
+
+						"variant": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerregistry.V6),
+								string(containerregistry.V7),
+								string(containerregistry.V8),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"agent_setting": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"cpu": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"agent_pool_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ContainerRegistryAgentPoolName,
+			},
+
+			"timeout_in_seconds": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntBetween(300, 28800),
+			},
+
+			"log_template": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"docker_step": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"docker_step", "encoded_step", "file_step"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"dockerfile_path": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"context_path": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"context_access_token": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"image_names": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"cache_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"push_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"target": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"arguments": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"secret_arguments": {
+							Type:      pluginsdk.TypeMap,
+							Optional:  true,
+							Sensitive: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"encoded_step": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"docker_step", "encoded_step", "file_step"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"task_content": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"value_content": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"context_path": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"context_access_token": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"values": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"secret_values": {
+							Type:      pluginsdk.TypeMap,
+							Optional:  true,
+							Sensitive: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"file_step": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"docker_step", "encoded_step", "file_step"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"task_file_path": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"value_file_path": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"context_path": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"context_access_token": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"values": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"secret_values": {
+							Type:      pluginsdk.TypeMap,
+							Optional:  true,
+							Sensitive: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"base_image_trigger": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerregistry.All),
+								string(containerregistry.Runtime),
+							}, false),
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"update_trigger_endpoint": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+
+						"update_trigger_payload_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerregistry.UpdateTriggerPayloadTypeDefault),
+								string(containerregistry.UpdateTriggerPayloadTypeToken),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"source_trigger": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"events": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(containerregistry.Commit),
+									string(containerregistry.Pullrequest),
+								}, false),
+							},
+						},
+
+						"repository_url": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+
+						"source_type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerregistry.Github),
+								string(containerregistry.VisualStudioTeamService),
+							}, false),
+						},
+
+						"branch": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"authentication": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"token": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"token_type": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(containerregistry.PAT),
+											string(containerregistry.OAuth),
+										}, false),
+									},
+
+									"expire_in_seconds": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+
+									"refresh_token": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"scope": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"timer_trigger": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"schedule": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
+			"registry_credential": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"source": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"login_mode": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(containerregistry.SourceRegistryLoginModeNone),
+											string(containerregistry.SourceRegistryLoginModeDefault),
+										}, false),
+									},
+								},
+							},
+						},
+
+						"custom": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"login_server": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"username": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"password": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"identity": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"identity": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"type": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppress.CaseDifference,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerregistry.ResourceIdentityTypeSystemAssigned),
+								string(containerregistry.ResourceIdentityTypeUserAssigned),
+								string(containerregistry.ResourceIdentityTypeSystemAssignedUserAssigned),
+							}, false),
+						},
+						"principal_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"identity_ids": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: identityValidate.UserAssignedIdentityID,
+							},
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceContainerRegistryTaskCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.RegistryTasksClient
+	registriesClient := meta.(*clients.Client).Containers.RegistriesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	registryId, err := parse.RegistryID(d.Get("container_registry_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewContainerRegistryTaskID(registryId.SubscriptionId, registryId.ResourceGroup, registryId.Name, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.TaskName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_container_registry_task", id.ID())
+		}
+	}
+
+	registry, err := registriesClient.Get(ctx, registryId.ResourceGroup, registryId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *registryId, err)
+	}
+
+	status := containerregistry.TaskStatusDisabled
+	if d.Get("enabled").(bool) {
+		status = containerregistry.TaskStatusEnabled
+	}
+
+	parameters := containerregistry.Task{
+		Location: registry.Location,
+		Identity: expandIdentityProperties(d.Get("identity").([]interface{})),
+		TaskProperties: &containerregistry.TaskProperties{
+			Status:             status,
+			Platform:           expandRegistryTaskPlatform(d.Get("platform").([]interface{})),
+			AgentConfiguration: expandRegistryTaskAgentSetting(d.Get("agent_setting").([]interface{})),
+			Timeout:            utils.Int32(int32(d.Get("timeout_in_seconds").(int))),
+			Trigger: &containerregistry.TriggerProperties{
+				BaseImageTrigger: expandRegistryTaskBaseImageTrigger(d.Get("base_image_trigger").([]interface{})),
+				SourceTriggers:   expandRegistryTaskSourceTriggers(d.Get("source_trigger").([]interface{})),
+				TimerTriggers:    expandRegistryTaskTimerTriggers(d.Get("timer_trigger").([]interface{})),
+			},
+			Credentials: expandRegistryTaskCredentials(d.Get("registry_credential").([]interface{})),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v := d.Get("agent_pool_name").(string); v != "" {
+		parameters.TaskProperties.AgentPoolName = utils.String(v)
+	}
+
+	if v := d.Get("log_template").(string); v != "" {
+		parameters.TaskProperties.LogTemplate = utils.String(v)
+	}
+
+	if v := d.Get("docker_step").([]interface{}); len(v) > 0 {
+		parameters.TaskProperties.Step = expandRegistryTaskDockerStep(v)
+	}
+	if v := d.Get("encoded_step").([]interface{}); len(v) > 0 {
+		parameters.TaskProperties.Step = expandRegistryTaskEncodedStep(v)
+	}
+	if v := d.Get("file_step").([]interface{}); len(v) > 0 {
+		parameters.TaskProperties.Step = expandRegistryTaskFileStep(v)
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.RegistryName, id.TaskName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceContainerRegistryTaskRead(d, meta)
+}
+
+func resourceContainerRegistryTaskRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.RegistryTasksClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ContainerRegistryTaskID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.TaskName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.TaskName)
+	d.Set("container_registry_id", parse.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.RegistryName).ID())
+
+	identity, err := flattenIdentityProperties(resp.Identity)
+	if err != nil {
+		return fmt.Errorf("flattening `identity`: %+v", err)
+	}
+	if resp.Identity != nil && resp.Identity.Type == containerregistry.ResourceIdentityTypeNone {
+		identity = []interface{}{}
+	}
+	if err := d.Set("identity", identity); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+
+	if props := resp.TaskProperties; props != nil {
+		d.Set("enabled", props.Status == containerregistry.TaskStatusEnabled)
+		d.Set("agent_pool_name", props.AgentPoolName)
+		d.Set("log_template", props.LogTemplate)
+
+		timeout := 0
+		if props.Timeout != nil {
+			timeout = int(*props.Timeout)
+		}
+		d.Set("timeout_in_seconds", timeout)
+
+		if err := d.Set("platform", flattenRegistryTaskPlatform(props.Platform)); err != nil {
+			return fmt.Errorf("setting `platform`: %+v", err)
+		}
+
+		if err := d.Set("agent_setting", flattenRegistryTaskAgentSetting(props.AgentConfiguration)); err != nil {
+			return fmt.Errorf("setting `agent_setting`: %+v", err)
+		}
+
+		dockerStep := make([]interface{}, 0)
+		encodedStep := make([]interface{}, 0)
+		fileStep := make([]interface{}, 0)
+		if step := props.Step; step != nil {
+			if v, ok := step.AsDockerBuildStep(); ok {
+				dockerStep = flattenRegistryTaskDockerStep(v, d.Get("docker_step").([]interface{}))
+			}
+			if v, ok := step.AsEncodedTaskStep(); ok {
+				encodedStep = flattenRegistryTaskEncodedStep(v, d.Get("encoded_step").([]interface{}))
+			}
+			if v, ok := step.AsFileTaskStep(); ok {
+				fileStep = flattenRegistryTaskFileStep(v, d.Get("file_step").([]interface{}))
+			}
+		}
+		if err := d.Set("docker_step", dockerStep); err != nil {
+			return fmt.Errorf("setting `docker_step`: %+v", err)
+		}
+		if err := d.Set("encoded_step", encodedStep); err != nil {
+			return fmt.Errorf("setting `encoded_step`: %+v", err)
+		}
+		if err := d.Set("file_step", fileStep); err != nil {
+			return fmt.Errorf("setting `file_step`: %+v", err)
+		}
+
+		baseImageTrigger := make([]interface{}, 0)
+		sourceTriggers := make([]interface{}, 0)
+		timerTriggers := make([]interface{}, 0)
+		if trigger := props.Trigger; trigger != nil {
+			baseImageTrigger = flattenRegistryTaskBaseImageTrigger(trigger.BaseImageTrigger)
+			sourceTriggers = flattenRegistryTaskSourceTriggers(trigger.SourceTriggers, d.Get("source_trigger").([]interface{}))
+			timerTriggers = flattenRegistryTaskTimerTriggers(trigger.TimerTriggers)
+		}
+		if err := d.Set("base_image_trigger", baseImageTrigger); err != nil {
+			return fmt.Errorf("setting `base_image_trigger`: %+v", err)
+		}
+		if err := d.Set("source_trigger", sourceTriggers); err != nil {
+			return fmt.Errorf("setting `source_trigger`: %+v", err)
+		}
+		if err := d.Set("timer_trigger", timerTriggers); err != nil {
+			return fmt.Errorf("setting `timer_trigger`: %+v", err)
+		}
+
+		if err := d.Set("registry_credential", flattenRegistryTaskCredentials(props.Credentials, d.Get("registry_credential").([]interface{}))); err != nil {
+			return fmt.Errorf("setting `registry_credential`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceContainerRegistryTaskDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.RegistryTasksClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ContainerRegistryTaskID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.RegistryName, id.TaskName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandRegistryTaskPlatform(input []interface{}) *containerregistry.PlatformProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &containerregistry.PlatformProperties{
+		Os:           containerregistry.OS(raw["os"].(string)),
+		Architecture: containerregistry.Architecture(raw["architecture"].(string)),
+		Variant:      containerregistry.Variant(raw["variant"].(string)),
+	}
+}
+
+func flattenRegistryTaskPlatform(input *containerregistry.PlatformProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"os":           string(input.Os),
+			"architecture": string(input.Architecture),
+			"variant":      string(input.Variant),
+		},
+	}
+}
+
+func expandRegistryTaskAgentSetting(input []interface{}) *containerregistry.AgentProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &containerregistry.AgentProperties{
+		CPU: utils.Int32(int32(raw["cpu"].(int))),
+	}
+}
+
+func flattenRegistryTaskAgentSetting(input *containerregistry.AgentProperties) []interface{} {
+	if input == nil || input.CPU == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cpu": int(*input.CPU),
+		},
+	}
+}
+
+func expandRegistryTaskDockerStep(input []interface{}) containerregistry.DockerBuildStep {
+	raw := input[0].(map[string]interface{})
+
+	step := containerregistry.DockerBuildStep{
+		DockerFilePath:     utils.String(raw["dockerfile_path"].(string)),
+		ContextPath:        utils.String(raw["context_path"].(string)),
+		ContextAccessToken: utils.String(raw["context_access_token"].(string)),
+		ImageNames:         utils.ExpandStringSlice(raw["image_names"].([]interface{})),
+		NoCache:            utils.Bool(!raw["cache_enabled"].(bool)),
+		IsPushEnabled:      utils.Bool(raw["push_enabled"].(bool)),
+		Arguments:          expandRegistryTaskArguments(raw["arguments"].(map[string]interface{}), raw["secret_arguments"].(map[string]interface{})),
+		Type:               containerregistry.TypeDocker,
+	}
+
+	if v := raw["target"].(string); v != "" {
+		step.Target = utils.String(v)
+	}
+
+	return step
+}
+
+func flattenRegistryTaskDockerStep(input *containerregistry.DockerBuildStep, existing []interface{}) []interface{} {
+	// the Context Access Token and Secret Arguments aren't returned by the API, so we retain these from the config
+	contextAccessToken := ""
+	secretArguments := map[string]interface{}{}
+	if len(existing) > 0 && existing[0] != nil {
+		raw := existing[0].(map[string]interface{})
+		contextAccessToken = raw["context_access_token"].(string)
+		secretArguments = raw["secret_arguments"].(map[string]interface{})
+	}
+
+	cacheEnabled := true
+	if input.NoCache != nil {
+		cacheEnabled = !*input.NoCache
+	}
+
+	pushEnabled := false
+	if input.IsPushEnabled != nil {
+		pushEnabled = *input.IsPushEnabled
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"dockerfile_path":      utils.NormalizeNilableString(input.DockerFilePath),
+			"context_path":         utils.NormalizeNilableString(input.ContextPath),
+			"context_access_token": contextAccessToken,
+			"image_names":          utils.FlattenStringSlice(input.ImageNames),
+			"cache_enabled":        cacheEnabled,
+			"push_enabled":         pushEnabled,
+			"target":               utils.NormalizeNilableString(input.Target),
+			"arguments":            flattenRegistryTaskArguments(input.Arguments),
+			"secret_arguments":     secretArguments,
+		},
+	}
+}
+
+func expandRegistryTaskEncodedStep(input []interface{}) containerregistry.EncodedTaskStep {
+	raw := input[0].(map[string]interface{})
+
+	step := containerregistry.EncodedTaskStep{
+		EncodedTaskContent: utils.String(raw["task_content"].(string)),
+		Values:             expandRegistryTaskValues(raw["values"].(map[string]interface{}), raw["secret_values"].(map[string]interface{})),
+		Type:               containerregistry.TypeEncodedTask,
+	}
+
+	if v := raw["value_content"].(string); v != "" {
+		step.EncodedValuesContent = utils.String(v)
+	}
+	if v := raw["context_path"].(string); v != "" {
+		step.ContextPath = utils.String(v)
+	}
+	if v := raw["context_access_token"].(string); v != "" {
+		step.ContextAccessToken = utils.String(v)
+	}
+
+	return step
+}
+
+func flattenRegistryTaskEncodedStep(input *containerregistry.EncodedTaskStep, existing []interface{}) []interface{} {
+	// the Context Access Token, Values Content and Secret Values aren't returned by the API, so we retain these from the config
+	contextAccessToken := ""
+	valueContent := ""
+	secretValues := map[string]interface{}{}
+	if len(existing) > 0 && existing[0] != nil {
+		raw := existing[0].(map[string]interface{})
+		contextAccessToken = raw["context_access_token"].(string)
+		valueContent = raw["value_content"].(string)
+		secretValues = raw["secret_values"].(map[string]interface{})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"task_content":         utils.NormalizeNilableString(input.EncodedTaskContent),
+			"value_content":        valueContent,
+			"context_path":         utils.NormalizeNilableString(input.ContextPath),
+			"context_access_token": contextAccessToken,
+			"values":               flattenRegistryTaskValues(input.Values),
+			"secret_values":        secretValues,
+		},
+	}
+}
+
+func expandRegistryTaskFileStep(input []interface{}) containerregistry.FileTaskStep {
+	raw := input[0].(map[string]interface{})
+
+	step := containerregistry.FileTaskStep{
+		TaskFilePath: utils.String(raw["task_file_path"].(string)),
+		Values:       expandRegistryTaskValues(raw["values"].(map[string]interface{}), raw["secret_values"].(map[string]interface{})),
+		Type:         containerregistry.TypeFileTask,
+	}
+
+	if v := raw["value_file_path"].(string); v != "" {
+		step.ValuesFilePath = utils.String(v)
+	}
+	if v := raw["context_path"].(string); v != "" {
+		step.ContextPath = utils.String(v)
+	}
+	if v := raw["context_access_token"].(string); v != "" {
+		step.ContextAccessToken = utils.String(v)
+	}
+
+	return step
+}
+
+func flattenRegistryTaskFileStep(input *containerregistry.FileTaskStep, existing []interface{}) []interface{} {
+	// the Context Access Token and Secret Values aren't returned by the API, so we retain these from the config
+	contextAccessToken := ""
+	secretValues := map[string]interface{}{}
+	if len(existing) > 0 && existing[0] != nil {
+		raw := existing[0].(map[string]interface{})
+		contextAccessToken = raw["context_access_token"].(string)
+		secretValues = raw["secret_values"].(map[string]interface{})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"task_file_path":       utils.NormalizeNilableString(input.TaskFilePath),
+			"value_file_path":      utils.NormalizeNilableString(input.ValuesFilePath),
+			"context_path":         utils.NormalizeNilableString(input.ContextPath),
+			"context_access_token": contextAccessToken,
+			"values":               flattenRegistryTaskValues(input.Values),
+			"secret_values":        secretValues,
+		},
+	}
+}
+
+func expandRegistryTaskArguments(arguments map[string]interface{}, secretArguments map[string]interface{}) *[]containerregistry.Argument {
+	output := make([]containerregistry.Argument, 0)
+	for k, v := range arguments {
+		output = append(output, containerregistry.Argument{
+			Name:     utils.String(k),
+			Value:    utils.String(v.(string)),
+			IsSecret: utils.Bool(false),
+		})
+	}
+	for k, v := range secretArguments {
+		output = append(output, containerregistry.Argument{
+			Name:     utils.String(k),
+			Value:    utils.String(v.(string)),
+			IsSecret: utils.Bool(true),
+		})
+	}
+	return &output
+}
+
+func flattenRegistryTaskArguments(input *[]containerregistry.Argument) map[string]interface{} {
+	output := make(map[string]interface{})
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		if item.Name == nil || (item.IsSecret != nil && *item.IsSecret) {
+			continue
+		}
+		output[*item.Name] = utils.NormalizeNilableString(item.Value)
+	}
+	return output
+}
+
+func expandRegistryTaskValues(values map[string]interface{}, secretValues map[string]interface{}) *[]containerregistry.SetValue {
+	output := make([]containerregistry.SetValue, 0)
+	for k, v := range values {
+		output = append(output, containerregistry.SetValue{
+			Name:     utils.String(k),
+			Value:    utils.String(v.(string)),
+			IsSecret: utils.Bool(false),
+		})
+	}
+	for k, v := range secretValues {
+		output = append(output, containerregistry.SetValue{
+			Name:     utils.String(k),
+			Value:    utils.String(v.(string)),
+			IsSecret: utils.Bool(true),
+		})
+	}
+	return &output
+}
+
+func flattenRegistryTaskValues(input *[]containerregistry.SetValue) map[string]interface{} {
+	output := make(map[string]interface{})
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		if item.Name == nil || (item.IsSecret != nil && *item.IsSecret) {
+			continue
+		}
+		output[*item.Name] = utils.NormalizeNilableString(item.Value)
+	}
+	return output
+}
+
+func expandRegistryTaskBaseImageTrigger(input []interface{}) *containerregistry.BaseImageTrigger {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	status := containerregistry.TriggerStatusDisabled
+	if raw["enabled"].(bool) {
+		status = containerregistry.TriggerStatusEnabled
+	}
+
+	trigger := &containerregistry.BaseImageTrigger{
+		Name:                     utils.String(raw["name"].(string)),
+		BaseImageTriggerType:     containerregistry.BaseImageTriggerType(raw["type"].(string)),
+		Status:                   status,
+		UpdateTriggerPayloadType: containerregistry.UpdateTriggerPayloadType(raw["update_trigger_payload_type"].(string)),
+	}
+
+	if v := raw["update_trigger_endpoint"].(string); v != "" {
+		trigger.UpdateTriggerEndpoint = utils.String(v)
+	}
+
+	return trigger
+}
+
+func flattenRegistryTaskBaseImageTrigger(input *containerregistry.BaseImageTrigger) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":                        utils.NormalizeNilableString(input.Name),
+			"type":                        string(input.BaseImageTriggerType),
+			"enabled":                     input.Status == containerregistry.TriggerStatusEnabled,
+			"update_trigger_endpoint":     utils.NormalizeNilableString(input.UpdateTriggerEndpoint),
+			"update_trigger_payload_type": string(input.UpdateTriggerPayloadType),
+		},
+	}
+}
+
+func expandRegistryTaskSourceTriggers(input []interface{}) *[]containerregistry.SourceTrigger {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make([]containerregistry.SourceTrigger, 0)
+	for _, item := range input {
+		raw := item.(map[string]interface{})
+
+		status := containerregistry.TriggerStatusDisabled
+		if raw["enabled"].(bool) {
+			status = containerregistry.TriggerStatusEnabled
+		}
+
+		events := make([]containerregistry.SourceTriggerEvent, 0)
+		for _, event := range raw["events"].([]interface{}) {
+			events = append(events, containerregistry.SourceTriggerEvent(event.(string)))
+		}
+
+		sourceRepository := &containerregistry.SourceProperties{
+			SourceControlType:           containerregistry.SourceControlType(raw["source_type"].(string)),
+			RepositoryURL:               utils.String(raw["repository_url"].(string)),
+			SourceControlAuthProperties: expandRegistryTaskSourceTriggerAuthentication(raw["authentication"].([]interface{})),
+		}
+		if v := raw["branch"].(string); v != "" {
+			sourceRepository.Branch = utils.String(v)
+		}
+
+		output = append(output, containerregistry.SourceTrigger{
+			Name:                utils.String(raw["name"].(string)),
+			SourceTriggerEvents: &events,
+			SourceRepository:    sourceRepository,
+			Status:              status,
+		})
+	}
+	return &output
+}
+
+func expandRegistryTaskSourceTriggerAuthentication(input []interface{}) *containerregistry.AuthInfo {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := &containerregistry.AuthInfo{
+		Token:     utils.String(raw["token"].(string)),
+		TokenType: containerregistry.TokenType(raw["token_type"].(string)),
+	}
+
+	if v := raw["expire_in_seconds"].(int); v != 0 {
+		output.ExpiresIn = utils.Int32(int32(v))
+	}
+	if v := raw["refresh_token"].(string); v != "" {
+		output.RefreshToken = utils.String(v)
+	}
+	if v := raw["scope"].(string); v != "" {
+		output.Scope = utils.String(v)
+	}
+
+	return output
+}
+
+func flattenRegistryTaskSourceTriggers(input *[]containerregistry.SourceTrigger, existing []interface{}) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	// the authentication block isn't returned by the API, so we retain this from the config, matching on the name
+	existingAuthentication := make(map[string]interface{})
+	for _, item := range existing {
+		if item == nil {
+			continue
+		}
+		raw := item.(map[string]interface{})
+		existingAuthentication[raw["name"].(string)] = raw["authentication"]
+	}
+
+	output := make([]interface{}, 0)
+	for _, item := range *input {
+		name := utils.NormalizeNilableString(item.Name)
+
+		events := make([]interface{}, 0)
+		if item.SourceTriggerEvents != nil {
+			for _, event := range *item.SourceTriggerEvents {
+				events = append(events, string(event))
+			}
+		}
+
+		repositoryUrl := ""
+		sourceType := ""
+		branch := ""
+		if repo := item.SourceRepository; repo != nil {
+			repositoryUrl = utils.NormalizeNilableString(repo.RepositoryURL)
+			sourceType = string(repo.SourceControlType)
+			branch = utils.NormalizeNilableString(repo.Branch)
+		}
+
+		authentication := []interface{}{}
+		if v, ok := existingAuthentication[name]; ok && v != nil {
+			authentication = v.([]interface{})
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":           name,
+			"events":         events,
+			"repository_url": repositoryUrl,
+			"source_type":    sourceType,
+			"branch":         branch,
+			"enabled":        item.Status == containerregistry.TriggerStatusEnabled,
+			"authentication": authentication,
+		})
+	}
+	return output
+}
+
+func expandRegistryTaskTimerTriggers(input []interface{}) *[]containerregistry.TimerTrigger {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make([]containerregistry.TimerTrigger, 0)
+	for _, item := range input {
+		raw := item.(map[string]interface{})
+
+		status := containerregistry.TriggerStatusDisabled
+		if raw["enabled"].(bool) {
+			status = containerregistry.TriggerStatusEnabled
+		}
+
+		output = append(output, containerregistry.TimerTrigger{
+			Name:     utils.String(raw["name"].(string)),
+			Schedule: utils.String(raw["schedule"].(string)),
+			Status:   status,
+		})
+	}
+	return &output
+}
+
+func flattenRegistryTaskTimerTriggers(input *[]containerregistry.TimerTrigger) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, item := range *input {
+		output = append(output, map[string]interface{}{
+			"name":     utils.NormalizeNilableString(item.Name),
+			"schedule": utils.NormalizeNilableString(item.Schedule),
+			"enabled":  item.Status == containerregistry.TriggerStatusEnabled,
+		})
+	}
+	return output
+}
+
+func expandRegistryTaskCredentials(input []interface{}) *containerregistry.Credentials {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := &containerregistry.Credentials{}
+
+	if v := raw["source"].([]interface{}); len(v) > 0 && v[0] != nil {
+		source := v[0].(map[string]interface{})
+		output.SourceRegistry = &containerregistry.SourceRegistryCredentials{
+			LoginMode: containerregistry.SourceRegistryLoginMode(source["login_mode"].(string)),
+		}
+	}
+
+	if v := raw["custom"].(*pluginsdk.Set).List(); len(v) > 0 {
+		customRegistries := make(map[string]*containerregistry.CustomRegistryCredentials)
+		for _, item := range v {
+			custom := item.(map[string]interface{})
+
+			credential := &containerregistry.CustomRegistryCredentials{}
+			if username := custom["username"].(string); username != "" {
+				credential.UserName = &containerregistry.SecretObject{
+					Value: utils.String(username),
+					Type:  containerregistry.Opaque,
+				}
+			}
+			if password := custom["password"].(string); password != "" {
+				credential.Password = &containerregistry.SecretObject{
+					Value: utils.String(password),
+					Type:  containerregistry.Opaque,
+				}
+			}
+			if identity := custom["identity"].(string); identity != "" {
+				credential.Identity = utils.String(identity)
+			}
+
+			customRegistries[custom["login_server"].(string)] = credential
+		}
+		output.CustomRegistries = customRegistries
+	}
+
+	return output
+}
+
+func flattenRegistryTaskCredentials(input *containerregistry.Credentials, existing []interface{}) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	// the username and password for custom registries aren't returned by the API, so we retain these from the config
+	existingCustom := make(map[string]map[string]interface{})
+	if len(existing) > 0 && existing[0] != nil {
+		raw := existing[0].(map[string]interface{})
+		if v, ok := raw["custom"].(*pluginsdk.Set); ok {
+			for _, item := range v.List() {
+				custom := item.(map[string]interface{})
+				existingCustom[strings.ToLower(custom["login_server"].(string))] = custom
+			}
+		}
+	}
+
+	source := make([]interface{}, 0)
+	if v := input.SourceRegistry; v != nil {
+		source = append(source, map[string]interface{}{
+			"login_mode": string(v.LoginMode),
+		})
+	}
+
+	custom := make([]interface{}, 0)
+	for loginServer, v := range input.CustomRegistries {
+		username := ""
+		password := ""
+		if config, ok := existingCustom[strings.ToLower(loginServer)]; ok {
+			username = config["username"].(string)
+			password = config["password"].(string)
+		}
+
+		identity := ""
+		if v != nil {
+			identity = utils.NormalizeNilableString(v.Identity)
+		}
+
+		custom = append(custom, map[string]interface{}{
+			"login_server": loginServer,
+			"username":     username,
+			"password":     password,
+			"identity":     identity,
+		})
+	}
+
+	if len(source) == 0 && len(custom) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"source": source,
+			"custom": custom,
+		},
+	}
+}
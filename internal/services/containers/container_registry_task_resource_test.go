@@ -0,0 +1,299 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerRegistryTaskResource struct{}
+
+func TestAccContainerRegistryTask_dockerStep(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
+	r := ContainerRegistryTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dockerStep(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("docker_step.0.context_access_token"),
+	})
+}
+
+func TestAccContainerRegistryTask_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
+	r := ContainerRegistryTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dockerStep(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerRegistryTask_encodedStep(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
+	r := ContainerRegistryTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.encodedStep(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("encoded_step.0.secret_values"),
+	})
+}
+
+func TestAccContainerRegistryTask_fileStep(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
+	r := ContainerRegistryTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.fileStep(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("file_step.0.context_access_token"),
+	})
+}
+
+func TestAccContainerRegistryTask_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
+	r := ContainerRegistryTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dockerStep(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("docker_step.0.context_access_token"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity.0.principal_id").Exists(),
+				check.That(data.ResourceName).Key("timer_trigger.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("docker_step.0.context_access_token", "docker_step.0.secret_arguments.%", "docker_step.0.secret_arguments.SECRET"),
+		{
+			Config: r.dockerStep(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("docker_step.0.context_access_token"),
+	})
+}
+
+func (r ContainerRegistryTaskResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ContainerRegistryTaskID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.RegistryTasksClient.Get(ctx, id.ResourceGroup, id.RegistryName, id.TaskName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.TaskProperties != nil), nil
+}
+
+func (r ContainerRegistryTaskResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccr%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r ContainerRegistryTaskResource) dockerStep(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "test" {
+  name                  = "testacc-task-%d"
+  container_registry_id = azurerm_container_registry.test.id
+
+  platform {
+    os = "Linux"
+  }
+
+  docker_step {
+    dockerfile_path      = "Dockerfile"
+    context_path         = "https://github.com/Azure-Samples/acr-build-helloworld-node#main"
+    context_access_token = "not-a-real-token"
+    image_names          = ["helloworld:{{.Run.ID}}"]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerRegistryTaskResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "import" {
+  name                  = azurerm_container_registry_task.test.name
+  container_registry_id = azurerm_container_registry_task.test.container_registry_id
+
+  platform {
+    os = "Linux"
+  }
+
+  docker_step {
+    dockerfile_path      = "Dockerfile"
+    context_path         = "https://github.com/Azure-Samples/acr-build-helloworld-node#main"
+    context_access_token = "not-a-real-token"
+    image_names          = ["helloworld:{{.Run.ID}}"]
+  }
+}
+`, r.dockerStep(data))
+}
+
+func (r ContainerRegistryTaskResource) encodedStep(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "test" {
+  name                  = "testacc-task-%d"
+  container_registry_id = azurerm_container_registry.test.id
+
+  platform {
+    os = "Linux"
+  }
+
+  encoded_step {
+    task_content = base64encode(<<EOT
+version: v1.1.0
+steps:
+  - cmd: bash echo {{.Values.message}}
+EOT
+    )
+
+    values = {
+      message = "hello"
+    }
+
+    secret_values = {
+      password = "s3cr3t!"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerRegistryTaskResource) fileStep(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "test" {
+  name                  = "testacc-task-%d"
+  container_registry_id = azurerm_container_registry.test.id
+
+  platform {
+    os = "Linux"
+  }
+
+  file_step {
+    task_file_path       = "taskmulti.yaml"
+    context_path         = "https://github.com/Azure-Samples/acr-tasks#main"
+    context_access_token = "not-a-real-token"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerRegistryTaskResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "test" {
+  name                  = "testacc-task-%d"
+  container_registry_id = azurerm_container_registry.test.id
+  enabled               = false
+  timeout_in_seconds    = 600
+  log_template          = "acr/tasks:{{.Run.OS}}"
+
+  platform {
+    os           = "Linux"
+    architecture = "amd64"
+  }
+
+  agent_setting {
+    cpu = 2
+  }
+
+  docker_step {
+    dockerfile_path      = "Dockerfile"
+    context_path         = "https://github.com/Azure-Samples/acr-build-helloworld-node#main"
+    context_access_token = "not-a-real-token"
+    image_names          = ["helloworld:{{.Run.ID}}"]
+    cache_enabled        = false
+    push_enabled         = false
+
+    arguments = {
+      NODE_VERSION = "16"
+    }
+
+    secret_arguments = {
+      SECRET = "s3cr3t!"
+    }
+  }
+
+  base_image_trigger {
+    name = "base-image"
+    type = "Runtime"
+  }
+
+  timer_trigger {
+    name     = "nightly"
+    schedule = "0 2 * * *"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,172 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceContainerRegistryTaskScheduleRunNow() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceContainerRegistryTaskScheduleRunNowCreate,
+		Read:   resourceContainerRegistryTaskScheduleRunNowRead,
+		Delete: resourceContainerRegistryTaskScheduleRunNowDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"container_registry_task_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ContainerRegistryTaskID,
+			},
+
+			// changing any of these values schedules a new run of the Task - for example when the Task's inputs change
+			"triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"run_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceContainerRegistryTaskScheduleRunNowCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	registriesClient := meta.(*clients.Client).Containers.RegistriesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	taskId, err := parse.ContainerRegistryTaskID(d.Get("container_registry_task_id").(string))
+	if err != nil {
+		return err
+	}
+
+	request := containerregistry.TaskRunRequest{
+		TaskID: utils.String(taskId.ID()),
+		Type:   containerregistry.TypeTaskRunRequest,
+	}
+
+	log.Printf("[DEBUG] Scheduling a Run for %s..", *taskId)
+	future, err := registriesClient.ScheduleRun(ctx, taskId.ResourceGroup, taskId.RegistryName, request)
+	if err != nil {
+		return fmt.Errorf("scheduling a Run for %s: %+v", *taskId, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, registriesClient.Client); err != nil {
+		return fmt.Errorf("waiting for a Run to be scheduled for %s: %+v", *taskId, err)
+	}
+	run, err := future.Result(*registriesClient)
+	if err != nil {
+		return fmt.Errorf("retrieving the Run scheduled for %s: %+v", *taskId, err)
+	}
+	if run.RunProperties == nil || run.RunProperties.RunID == nil {
+		return fmt.Errorf("retrieving the Run scheduled for %s: `runId` was nil", *taskId)
+	}
+
+	id := parse.NewContainerRegistryRunID(taskId.SubscriptionId, taskId.ResourceGroup, taskId.RegistryName, *run.RunProperties.RunID)
+
+	log.Printf("[DEBUG] Waiting for %s to complete..", id)
+	timeout, _ := ctx.Deadline()
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(containerregistry.RunStatusQueued),
+			string(containerregistry.RunStatusStarted),
+			string(containerregistry.RunStatusRunning),
+		},
+		Target:     []string{string(containerregistry.RunStatusSucceeded)},
+		Refresh:    containerRegistryRunStatusRefreshFunc(ctx, meta, id),
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(timeout),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to complete: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceContainerRegistryTaskScheduleRunNowRead(d, meta)
+}
+
+func resourceContainerRegistryTaskScheduleRunNowRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.RegistryRunsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ContainerRegistryRunID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.RunName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("run_id", id.RunName)
+
+	if props := resp.RunProperties; props != nil && props.Task != nil {
+		d.Set("container_registry_task_id", parse.NewContainerRegistryTaskID(id.SubscriptionId, id.ResourceGroup, id.RegistryName, *props.Task).ID())
+	}
+
+	return nil
+}
+
+func resourceContainerRegistryTaskScheduleRunNowDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	// a Run can't be deleted, it's retained in the Run history of the Container Registry
+	log.Printf("[DEBUG] Removing %q from state - the Run is retained in the Container Registry's history", d.Id())
+	return nil
+}
+
+func containerRegistryRunStatusRefreshFunc(ctx context.Context, meta interface{}, id parse.ContainerRegistryRunId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		client := meta.(*clients.Client).Containers.RegistryRunsClient
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.RegistryName, id.RunName)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if resp.RunProperties == nil {
+			return nil, "", fmt.Errorf("retrieving %s: `properties` was nil", id)
+		}
+
+		status := resp.RunProperties.Status
+		switch status {
+		case containerregistry.RunStatusFailed, containerregistry.RunStatusCanceled, containerregistry.RunStatusError, containerregistry.RunStatusTimeout:
+			message := ""
+			if resp.RunProperties.RunErrorMessage != nil {
+				message = *resp.RunProperties.RunErrorMessage
+			}
+			return resp, string(status), fmt.Errorf("%s completed with the status %q: %s", id, string(status), message)
+		}
+
+		return resp, string(status), nil
+	}
+}
@@ -0,0 +1,106 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerRegistryTaskScheduleRunNowResource struct{}
+
+func TestAccContainerRegistryTaskScheduleRunNow_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task_schedule_run_now", "test")
+	r := ContainerRegistryTaskScheduleRunNowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "hello"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("run_id").Exists(),
+			),
+		},
+		{
+			// changing the Task's inputs schedules a new Run
+			Config: r.basic(data, "world"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("run_id").Exists(),
+			),
+		},
+	})
+}
+
+func (r ContainerRegistryTaskScheduleRunNowResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ContainerRegistryRunID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.RegistryRunsClient.Get(ctx, id.ResourceGroup, id.RegistryName, id.RunName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.RunProperties != nil), nil
+}
+
+func (r ContainerRegistryTaskScheduleRunNowResource) basic(data acceptance.TestData, message string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccr%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+}
+
+resource "azurerm_container_registry_task" "test" {
+  name                  = "testacc-task-%[1]d"
+  container_registry_id = azurerm_container_registry.test.id
+
+  platform {
+    os = "Linux"
+  }
+
+  encoded_step {
+    task_content = base64encode(<<EOT
+version: v1.1.0
+steps:
+  - cmd: bash echo {{.Values.message}}
+EOT
+    )
+
+    values = {
+      message = "%[3]s"
+    }
+  }
+}
+
+resource "azurerm_container_registry_task_schedule_run_now" "test" {
+  container_registry_task_id = azurerm_container_registry_task.test.id
+
+  triggers = {
+    message = "%[3]s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, message)
+}
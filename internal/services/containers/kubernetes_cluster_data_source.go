@@ -5,7 +5,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-08-01/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/preview/containerservice/mgmt/2022-03-02-preview/containerservice"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/kubernetes"
@@ -586,6 +586,16 @@ func dataSourceKubernetesCluster() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"oidc_issuer_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"oidc_issuer_url": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"role_based_access_control": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -648,6 +658,11 @@ func dataSourceKubernetesCluster() *pluginsdk.Resource {
 			},
 
 			"tags": tags.SchemaDataSource(),
+
+			"workload_identity_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -690,6 +705,11 @@ func dataSourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{}
 		d.Set("kubernetes_version", props.KubernetesVersion)
 		d.Set("node_resource_group", props.NodeResourceGroup)
 
+		oidcIssuerEnabled, oidcIssuerUrl := flattenKubernetesClusterOidcIssuerProfile(props.OidcIssuerProfile)
+		d.Set("oidc_issuer_enabled", oidcIssuerEnabled)
+		d.Set("oidc_issuer_url", oidcIssuerUrl)
+		d.Set("workload_identity_enabled", flattenKubernetesClusterWorkloadIdentity(props.SecurityProfile))
+
 		// TODO: 2.0 we should introduce a access_profile block to match the new API design,
 		if accessProfile := props.APIServerAccessProfile; accessProfile != nil {
 			apiServerAuthorizedIPRanges := utils.FlattenStringSlice(accessProfile.AuthorizedIPRanges)
@@ -1000,8 +1020,8 @@ func flattenKubernetesClusterDataSourceAddonProfiles(profile map[string]*contain
 		}
 
 		enableSecretRotation := "false"
-		if v := kubernetesAddonProfilelocateInConfig(azureKeyvaultSecretsProvider.Config, "enableSecretRotation"); v == utils.String("true") {
-			enableSecretRotation = *v
+		if v := kubernetesAddonProfilelocateInConfig(azureKeyvaultSecretsProvider.Config, "enableSecretRotation"); v != nil && strings.EqualFold(*v, "true") {
+			enableSecretRotation = "true"
 		}
 
 		rotationPollInterval := ""
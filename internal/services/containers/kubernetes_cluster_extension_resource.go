@@ -0,0 +1,380 @@
+package containers
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2022-03-01/extensions"
+	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceKubernetesClusterExtension() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceKubernetesClusterExtensionCreate,
+		Read:   resourceKubernetesClusterExtensionRead,
+		Update: resourceKubernetesClusterExtensionUpdate,
+		Delete: resourceKubernetesClusterExtensionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := extensions.ParseExtensionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: containerValidate.KubernetesClusterExtensionName,
+			},
+
+			"cluster_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: containerValidate.ClusterID,
+			},
+
+			"extension_type": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"configuration_protected_settings": {
+				Type:      pluginsdk.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"configuration_settings": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"release_train": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"version"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
+			"release_namespace": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"target_namespace"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
+			"target_namespace": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"release_namespace"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
+			"version": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"release_train"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
+			"aks_assigned_identity": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"principal_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"tenant_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"current_version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKubernetesClusterExtensionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.ExtensionsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	clusterId, err := parse.ClusterID(d.Get("cluster_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := extensions.NewExtensionID(clusterId.SubscriptionId, clusterId.ResourceGroup, clusterId.ManagedClusterName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_kubernetes_cluster_extension", id.ID())
+	}
+
+	properties := extensions.ExtensionProperties{
+		AutoUpgradeMinorVersion:        utils.Bool(true),
+		ConfigurationProtectedSettings: expandKubernetesClusterExtensionSettings(d.Get("configuration_protected_settings").(map[string]interface{})),
+		ConfigurationSettings:          expandKubernetesClusterExtensionSettings(d.Get("configuration_settings").(map[string]interface{})),
+		ExtensionType:                  utils.String(d.Get("extension_type").(string)),
+		Scope:                          expandKubernetesClusterExtensionScope(d.Get("release_namespace").(string), d.Get("target_namespace").(string)),
+	}
+
+	if v, ok := d.GetOk("release_train"); ok {
+		properties.ReleaseTrain = utils.String(v.(string))
+	}
+
+	// pinning a version disables automatic upgrades of the extension
+	if v, ok := d.GetOk("version"); ok {
+		properties.AutoUpgradeMinorVersion = utils.Bool(false)
+		properties.Version = utils.String(v.(string))
+	}
+
+	parameters := extensions.Extension{
+		Properties: &properties,
+	}
+	if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceKubernetesClusterExtensionRead(d, meta)
+}
+
+func resourceKubernetesClusterExtensionUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.ExtensionsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := extensions.ParseExtensionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	properties := extensions.PatchExtensionProperties{}
+
+	if d.HasChange("configuration_protected_settings") {
+		properties.ConfigurationProtectedSettings = expandKubernetesClusterExtensionSettings(d.Get("configuration_protected_settings").(map[string]interface{}))
+	}
+
+	if d.HasChange("configuration_settings") {
+		properties.ConfigurationSettings = expandKubernetesClusterExtensionSettings(d.Get("configuration_settings").(map[string]interface{}))
+	}
+
+	if d.HasChange("version") {
+		if v, ok := d.GetOk("version"); ok {
+			properties.AutoUpgradeMinorVersion = utils.Bool(false)
+			properties.Version = utils.String(v.(string))
+		} else {
+			properties.AutoUpgradeMinorVersion = utils.Bool(true)
+		}
+	}
+
+	parameters := extensions.PatchExtension{
+		Properties: &properties,
+	}
+	if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceKubernetesClusterExtensionRead(d, meta)
+}
+
+func resourceKubernetesClusterExtensionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.ExtensionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := extensions.ParseExtensionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ExtensionName)
+	d.Set("cluster_id", parse.NewClusterID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("current_version", props.CurrentVersion)
+			d.Set("extension_type", props.ExtensionType)
+			d.Set("release_train", props.ReleaseTrain)
+
+			// the API doesn't return the protected settings, so these are retained from the config
+			if err := d.Set("configuration_settings", flattenKubernetesClusterExtensionSettings(props.ConfigurationSettings)); err != nil {
+				return fmt.Errorf("setting `configuration_settings`: %+v", err)
+			}
+
+			if err := d.Set("aks_assigned_identity", flattenKubernetesClusterExtensionAksAssignedIdentity(props.AksAssignedIdentity)); err != nil {
+				return fmt.Errorf("setting `aks_assigned_identity`: %+v", err)
+			}
+
+			// the version is only returned when it's been pinned, since otherwise the extension is auto-upgraded
+			version := ""
+			if props.AutoUpgradeMinorVersion != nil && !*props.AutoUpgradeMinorVersion && props.Version != nil {
+				version = *props.Version
+			}
+			d.Set("version", version)
+
+			releaseNamespace := ""
+			targetNamespace := ""
+			if scope := props.Scope; scope != nil {
+				if scope.Cluster != nil && scope.Cluster.ReleaseNamespace != nil {
+					releaseNamespace = *scope.Cluster.ReleaseNamespace
+				}
+				if scope.Namespace != nil && scope.Namespace.TargetNamespace != nil {
+					targetNamespace = *scope.Namespace.TargetNamespace
+				}
+			}
+			d.Set("release_namespace", releaseNamespace)
+			d.Set("target_namespace", targetNamespace)
+		}
+	}
+
+	return nil
+}
+
+func resourceKubernetesClusterExtensionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.ExtensionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := extensions.ParseExtensionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id, extensions.DefaultDeleteOptions()); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandKubernetesClusterExtensionScope(releaseNamespace, targetNamespace string) *extensions.Scope {
+	if targetNamespace != "" {
+		return &extensions.Scope{
+			Namespace: &extensions.ScopeNamespace{
+				TargetNamespace: utils.String(targetNamespace),
+			},
+		}
+	}
+
+	// when neither is specified the extension is cluster-scoped and the API defaults the release namespace
+	scope := extensions.Scope{
+		Cluster: &extensions.ScopeCluster{},
+	}
+	if releaseNamespace != "" {
+		scope.Cluster.ReleaseNamespace = utils.String(releaseNamespace)
+	}
+	return &scope
+}
+
+func expandKubernetesClusterExtensionSettings(input map[string]interface{}) *map[string]string {
+	output := make(map[string]string)
+	for k, v := range input {
+		output[k] = v.(string)
+	}
+	return &output
+}
+
+func flattenKubernetesClusterExtensionSettings(input *map[string]string) map[string]interface{} {
+	output := make(map[string]interface{})
+	if input == nil {
+		return output
+	}
+
+	for k, v := range *input {
+		output[k] = v
+	}
+	return output
+}
+
+func flattenKubernetesClusterExtensionAksAssignedIdentity(input *extensions.ExtensionPropertiesAksAssignedIdentity) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	principalId := ""
+	if input.PrincipalId != nil {
+		principalId = *input.PrincipalId
+	}
+
+	tenantId := ""
+	if input.TenantId != nil {
+		tenantId = *input.TenantId
+	}
+
+	identityType := ""
+	if input.Type != nil {
+		identityType = *input.Type
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+			"type":         identityType,
+		},
+	}
+}
@@ -1,12 +1,13 @@
 package containers
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-08-01/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/preview/containerservice/mgmt/2022-03-02-preview/containerservice"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -46,6 +47,26 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			0: migration.KubernetesClusterNodePoolV0ToV1{},
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+			// confirm at plan time that the Node Pool won't run a newer version of Kubernetes than the Control Plane
+			if !d.HasChange("orchestrator_version") || !d.NewValueKnown("orchestrator_version") || !d.NewValueKnown("kubernetes_cluster_id") {
+				return nil
+			}
+
+			orchestratorVersion := d.Get("orchestrator_version").(string)
+			clusterIdRaw := d.Get("kubernetes_cluster_id").(string)
+			if orchestratorVersion == "" || clusterIdRaw == "" {
+				return nil
+			}
+
+			clusterId, err := parse.ClusterID(clusterIdRaw)
+			if err != nil {
+				return err
+			}
+
+			return validateNodePoolVersionDuringPlan(ctx, meta.(*clients.Client).Containers, clusterId.ResourceGroup, clusterId.ManagedClusterName, d.Get("name").(string), orchestratorVersion)
+		}),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -191,6 +212,13 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				},
 			},
 
+			"node_image_version": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
 			"orchestrator_version": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -504,6 +532,14 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 	id := parse.NewNodePoolID(poolsClient.SubscriptionID, resourceGroup, clusterName, name)
 	d.SetId(id.ID())
 
+	// Node Pools are always created using the latest Node Image, as such this is a no-op unless a newer Node Image became
+	// available during creation - and otherwise errors if a version other than the latest Node Image was specified
+	if nodeImageVersion := d.Get("node_image_version").(string); nodeImageVersion != "" {
+		if err := upgradeNodePoolNodeImageVersion(ctx, poolsClient, id, nodeImageVersion); err != nil {
+			return err
+		}
+	}
+
 	return resourceKubernetesClusterNodePoolRead(d, meta)
 }
 
@@ -650,6 +686,15 @@ func resourceKubernetesClusterNodePoolUpdate(d *pluginsdk.ResourceData, meta int
 		return fmt.Errorf("waiting for update of Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", id.AgentPoolName, id.ManagedClusterName, id.ResourceGroup, err)
 	}
 
+	// a Node Image upgrade is performed after any Orchestrator Version upgrade, since that also updates the Node Image
+	if d.HasChange("node_image_version") {
+		if nodeImageVersion := d.Get("node_image_version").(string); nodeImageVersion != "" {
+			if err := upgradeNodePoolNodeImageVersion(ctx, client, *id, nodeImageVersion); err != nil {
+				return err
+			}
+		}
+	}
+
 	d.Partial(false)
 
 	return resourceKubernetesClusterNodePoolRead(d, meta)
@@ -764,6 +809,7 @@ func resourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta inter
 			return fmt.Errorf("setting `node_taints`: %+v", err)
 		}
 
+		d.Set("node_image_version", props.NodeImageVersion)
 		d.Set("orchestrator_version", props.OrchestratorVersion)
 		osDiskSizeGB := 0
 		if props.OsDiskSizeGB != nil {
@@ -816,7 +862,7 @@ func resourceKubernetesClusterNodePoolDelete(d *pluginsdk.ResourceData, meta int
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.ManagedClusterName, id.AgentPoolName)
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ManagedClusterName, id.AgentPoolName, nil)
 	if err != nil {
 		return fmt.Errorf("deleting Node Pool %q (Managed Kubernetes Cluster %q / Resource Group %q): %+v", id.AgentPoolName, id.ManagedClusterName, id.ResourceGroup, err)
 	}
@@ -888,3 +934,41 @@ func flattenUpgradeSettings(input *containerservice.AgentPoolUpgradeSettings) []
 		},
 	}
 }
+
+// upgradeNodePoolNodeImageVersion upgrades the Node Image used for the Nodes within the Node Pool, without changing
+// the version of Kubernetes. Since the API only supports upgrading to the latest Node Image, the desired version
+// must match the latest version available for this Node Pool.
+func upgradeNodePoolNodeImageVersion(ctx context.Context, client *containerservice.AgentPoolsClient, id parse.NodePoolId, desiredVersion string) error {
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ManagedClusterName, id.AgentPoolName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if props := existing.ManagedClusterAgentPoolProfileProperties; props != nil && props.NodeImageVersion != nil && *props.NodeImageVersion == desiredVersion {
+		log.Printf("[DEBUG] %s is already using Node Image %q - skipping upgrade", id, desiredVersion)
+		return nil
+	}
+
+	profile, err := client.GetUpgradeProfile(ctx, id.ResourceGroup, id.ManagedClusterName, id.AgentPoolName)
+	if err != nil {
+		return fmt.Errorf("retrieving Upgrade Profile for %s: %+v", id, err)
+	}
+	latestVersion := ""
+	if props := profile.AgentPoolUpgradeProfileProperties; props != nil && props.LatestNodeImageVersion != nil {
+		latestVersion = *props.LatestNodeImageVersion
+	}
+	if latestVersion != desiredVersion {
+		return fmt.Errorf("the Node Image version %q is not available for %s - Node Pools can only be upgraded to the latest Node Image version %q", desiredVersion, id, latestVersion)
+	}
+
+	log.Printf("[DEBUG] Upgrading the Node Image for %s to %q..", id, desiredVersion)
+	future, err := client.UpgradeNodeImageVersion(ctx, id.ResourceGroup, id.ManagedClusterName, id.AgentPoolName)
+	if err != nil {
+		return fmt.Errorf("upgrading the Node Image for %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the Node Image upgrade for %s: %+v", id, err)
+	}
+	log.Printf("[DEBUG] Upgraded the Node Image for %s to %q.", id, desiredVersion)
+
+	return nil
+}
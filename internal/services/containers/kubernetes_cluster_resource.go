@@ -8,7 +8,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-08-01/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/preview/containerservice/mgmt/2022-03-02-preview/containerservice"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -49,6 +49,26 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("service_principal.0.client_id", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old == "msi" || old == ""
 			}),
+			// the Default Node Pool can't run a newer version of Kubernetes than the Control Plane
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+				if !d.NewValueKnown("kubernetes_version") || !d.NewValueKnown("default_node_pool.0.orchestrator_version") {
+					return nil
+				}
+
+				nodePoolName := d.Get("default_node_pool.0.name").(string)
+				nodePoolVersion := d.Get("default_node_pool.0.orchestrator_version").(string)
+				return validateNodePoolVersionDoesNotExceedControlPlane(nodePoolName, nodePoolVersion, d.Get("kubernetes_version").(string))
+			},
+			// the OIDC Issuer can't be disabled once it's been enabled
+			pluginsdk.ForceNewIfChange("oidc_issuer_enabled", func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(bool) && !new.(bool)
+			}),
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+				if d.Get("workload_identity_enabled").(bool) && !d.Get("oidc_issuer_enabled").(bool) {
+					return fmt.Errorf("`oidc_issuer_enabled` must be set to `true` when `workload_identity_enabled` is `true`")
+				}
+				return nil
+			},
 		),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -609,6 +629,16 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			"oidc_issuer_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"oidc_issuer_url": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"private_fqdn": { // privateFqdn
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -815,6 +845,11 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"workload_identity_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
 			"automatic_channel_upgrade": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -1158,6 +1193,16 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		Tags: tags.Expand(t),
 	}
 
+	if d.Get("oidc_issuer_enabled").(bool) {
+		parameters.ManagedClusterProperties.OidcIssuerProfile = expandKubernetesClusterOidcIssuerProfile(true)
+	}
+
+	if d.Get("workload_identity_enabled").(bool) {
+		parameters.ManagedClusterProperties.SecurityProfile = &containerservice.ManagedClusterSecurityProfile{
+			WorkloadIdentity: expandKubernetesClusterWorkloadIdentity(true),
+		}
+	}
+
 	if v := d.Get("automatic_channel_upgrade").(string); v != "" {
 		parameters.ManagedClusterProperties.AutoUpgradeProfile = &containerservice.ManagedClusterAutoUpgradeProfile{
 			UpgradeChannel: containerservice.UpgradeChannel(v),
@@ -1544,6 +1589,19 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		existing.ManagedClusterProperties.HTTPProxyConfig = httpProxyConfig
 	}
 
+	if d.HasChange("oidc_issuer_enabled") {
+		updateCluster = true
+		existing.ManagedClusterProperties.OidcIssuerProfile = expandKubernetesClusterOidcIssuerProfile(d.Get("oidc_issuer_enabled").(bool))
+	}
+
+	if d.HasChange("workload_identity_enabled") {
+		updateCluster = true
+		if existing.ManagedClusterProperties.SecurityProfile == nil {
+			existing.ManagedClusterProperties.SecurityProfile = &containerservice.ManagedClusterSecurityProfile{}
+		}
+		existing.ManagedClusterProperties.SecurityProfile.WorkloadIdentity = expandKubernetesClusterWorkloadIdentity(d.Get("workload_identity_enabled").(bool))
+	}
+
 	if updateCluster {
 		log.Printf("[DEBUG] Updating the Kubernetes Cluster %q (Resource Group %q)..", id.ManagedClusterName, id.ResourceGroup)
 		future, err := clusterClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, existing)
@@ -1763,6 +1821,11 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `http_proxy_config`: %+v", err)
 		}
 
+		oidcIssuerEnabled, oidcIssuerUrl := flattenKubernetesClusterOidcIssuerProfile(props.OidcIssuerProfile)
+		d.Set("oidc_issuer_enabled", oidcIssuerEnabled)
+		d.Set("oidc_issuer_url", oidcIssuerUrl)
+		d.Set("workload_identity_enabled", flattenKubernetesClusterWorkloadIdentity(props.SecurityProfile))
+
 		// adminProfile is only available for RBAC enabled clusters with AAD and local account is not disabled
 		if props.AadProfile != nil && (props.DisableLocalAccounts == nil || !*props.DisableLocalAccounts) {
 			adminProfile, err := client.GetAccessProfile(ctx, id.ResourceGroup, id.ManagedClusterName, "clusterAdmin")
@@ -1822,7 +1885,7 @@ func resourceKubernetesClusterDelete(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.ManagedClusterName)
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ManagedClusterName, nil)
 	if err != nil {
 		return fmt.Errorf("deleting Managed Kubernetes Cluster %q (Resource Group %q): %+v", id.ManagedClusterName, id.ResourceGroup, err)
 	}
@@ -2861,3 +2924,41 @@ func flattenKubernetesClusterHttpProxyConfig(props *containerservice.ManagedClus
 	})
 
 }
+
+func expandKubernetesClusterOidcIssuerProfile(enabled bool) *containerservice.ManagedClusterOIDCIssuerProfile {
+	return &containerservice.ManagedClusterOIDCIssuerProfile{
+		Enabled: utils.Bool(enabled),
+	}
+}
+
+func flattenKubernetesClusterOidcIssuerProfile(input *containerservice.ManagedClusterOIDCIssuerProfile) (bool, string) {
+	if input == nil {
+		return false, ""
+	}
+
+	enabled := false
+	if input.Enabled != nil {
+		enabled = *input.Enabled
+	}
+
+	issuerUrl := ""
+	if input.IssuerURL != nil {
+		issuerUrl = *input.IssuerURL
+	}
+
+	return enabled, issuerUrl
+}
+
+func expandKubernetesClusterWorkloadIdentity(enabled bool) *containerservice.ManagedClusterSecurityProfileWorkloadIdentity {
+	return &containerservice.ManagedClusterSecurityProfileWorkloadIdentity{
+		Enabled: utils.Bool(enabled),
+	}
+}
+
+func flattenKubernetesClusterWorkloadIdentity(input *containerservice.ManagedClusterSecurityProfile) bool {
+	if input == nil || input.WorkloadIdentity == nil || input.WorkloadIdentity.Enabled == nil {
+		return false
+	}
+
+	return *input.WorkloadIdentity.Enabled
+}
@@ -6,7 +6,8 @@ import (
 	"net/http"
 	"strings"
 
-	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2021-08-01/containerservice"
+	"github.com/Azure/azure-sdk-for-go/services/preview/containerservice/mgmt/2022-03-02-preview/containerservice"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -290,3 +291,103 @@ func validateNodePoolSupportsVersion(ctx context.Context, client *client.Client,
 
 	return nil
 }
+
+// validateNodePoolVersionDoesNotExceedControlPlane confirms that the version of Kubernetes used for a Node Pool
+// is no newer than the version of the Control Plane, which isn't supported by the Kubernetes version skew policy
+func validateNodePoolVersionDoesNotExceedControlPlane(nodePoolName, nodePoolVersion, controlPlaneVersion string) error {
+	if nodePoolVersion == "" || controlPlaneVersion == "" {
+		return nil
+	}
+
+	nodePool, err := version.NewVersion(nodePoolVersion)
+	if err != nil {
+		return fmt.Errorf("parsing the Orchestrator Version %q for Node Pool %q: %+v", nodePoolVersion, nodePoolName, err)
+	}
+
+	controlPlane, err := version.NewVersion(controlPlaneVersion)
+	if err != nil {
+		return fmt.Errorf("parsing the Kubernetes Version %q for the Control Plane: %+v", controlPlaneVersion, err)
+	}
+
+	// the Control Plane version may be less precise than the Node Pool version (e.g. `1.21` rather than `1.21.2`), in which
+	// case any patch version of `1.21` is acceptable - so only compare the segments specified for the Control Plane
+	precision := len(strings.Split(strings.SplitN(strings.TrimPrefix(controlPlaneVersion, "v"), "-", 2)[0], "."))
+	if compareVersionSegments(nodePool.Segments(), controlPlane.Segments(), precision) > 0 {
+		return fmt.Errorf(`
+The Kubernetes/Orchestrator Version %q for Node Pool %q is newer than the version of the
+Control Plane (%q).
+
+Node Pools cannot use a version of Kubernetes that is not supported on the Control Plane. Please
+upgrade the Control Plane (using the 'kubernetes_version' field) first. More details can be found
+at https://aka.ms/version-skew-policy.
+`, nodePoolVersion, nodePoolName, controlPlaneVersion)
+	}
+
+	return nil
+}
+
+// compareVersionSegments compares the first `precision` segments of two versions, returning -1, 0 or 1
+func compareVersionSegments(first, second []int, precision int) int {
+	for i := 0; i < precision; i++ {
+		var a, b int
+		if i < len(first) {
+			a = first[i]
+		}
+		if i < len(second) {
+			b = second[i]
+		}
+
+		if a < b {
+			return -1
+		}
+		if a > b {
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// validateNodePoolVersionDuringPlan confirms that the version of Kubernetes requested for a Node Pool can be used
+// once the Control Plane has been upgraded. Since the Control Plane may be upgraded within the same apply (prior
+// to the Node Pool) the Node Pool version can be either the current version or an available upgrade for the
+// Control Plane - the exact version is then confirmed at apply time via `validateNodePoolSupportsVersion`.
+func validateNodePoolVersionDuringPlan(ctx context.Context, client *client.Client, resourceGroup, clusterName, nodePoolName, desiredNodePoolVersion string) error {
+	profile, err := client.KubernetesClustersClient.GetUpgradeProfile(ctx, resourceGroup, clusterName)
+	if err != nil {
+		// the Cluster may not exist yet, in which case this is checked at apply time
+		if utils.ResponseWasNotFound(profile.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving Upgrade Profile for Kubernetes Cluster %q (Resource Group %q): %+v", clusterName, resourceGroup, err)
+	}
+	if profile.ManagedClusterUpgradeProfileProperties == nil || profile.ManagedClusterUpgradeProfileProperties.ControlPlaneProfile == nil {
+		return nil
+	}
+	controlPlane := profile.ManagedClusterUpgradeProfileProperties.ControlPlaneProfile
+
+	candidates := make([]string, 0)
+	if controlPlane.KubernetesVersion != nil {
+		candidates = append(candidates, *controlPlane.KubernetesVersion)
+	}
+	if controlPlane.Upgrades != nil {
+		for _, upgrade := range *controlPlane.Upgrades {
+			if upgrade.KubernetesVersion != nil {
+				candidates = append(candidates, *upgrade.KubernetesVersion)
+			}
+		}
+	}
+
+	var lastErr error
+	for _, candidate := range candidates {
+		if lastErr = validateNodePoolVersionDoesNotExceedControlPlane(nodePoolName, desiredNodePoolVersion, candidate); lastErr == nil {
+			return nil
+		}
+	}
+
+	if lastErr != nil {
+		return clusterControlPlaneMustBeUpgradedError(resourceGroup, clusterName, nodePoolName, controlPlane.KubernetesVersion, desiredNodePoolVersion, candidates)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package containers
+
+import "testing"
+
+func TestValidateNodePoolVersionDoesNotExceedControlPlane(t *testing.T) {
+	testData := []struct {
+		nodePoolVersion     string
+		controlPlaneVersion string
+		expectError         bool
+	}{
+		{
+			// unknown versions are validated elsewhere
+			nodePoolVersion:     "",
+			controlPlaneVersion: "1.21.2",
+			expectError:         false,
+		},
+		{
+			nodePoolVersion:     "1.21.2",
+			controlPlaneVersion: "",
+			expectError:         false,
+		},
+		{
+			nodePoolVersion:     "1.21.2",
+			controlPlaneVersion: "1.21.2",
+			expectError:         false,
+		},
+		{
+			nodePoolVersion:     "1.20.9",
+			controlPlaneVersion: "1.21.2",
+			expectError:         false,
+		},
+		{
+			nodePoolVersion:     "1.21",
+			controlPlaneVersion: "1.21.2",
+			expectError:         false,
+		},
+		{
+			nodePoolVersion:     "1.21.7",
+			controlPlaneVersion: "1.21.2",
+			expectError:         true,
+		},
+		{
+			nodePoolVersion:     "1.22.4",
+			controlPlaneVersion: "1.21.2",
+			expectError:         true,
+		},
+		{
+			// the Control Plane version is only specified to the minor version, so any patch version is acceptable
+			nodePoolVersion:     "1.21.2",
+			controlPlaneVersion: "1.21",
+			expectError:         false,
+		},
+		{
+			nodePoolVersion:     "1.22.1",
+			controlPlaneVersion: "1.21",
+			expectError:         true,
+		},
+		{
+			nodePoolVersion:     "latest",
+			controlPlaneVersion: "1.21.2",
+			expectError:         true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing Node Pool %q / Control Plane %q", v.nodePoolVersion, v.controlPlaneVersion)
+
+		err := validateNodePoolVersionDoesNotExceedControlPlane("default", v.nodePoolVersion, v.controlPlaneVersion)
+		if v.expectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.expectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+}
@@ -0,0 +1,575 @@
+package containers
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2022-03-01/fluxconfiguration"
+	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	fluxRepositoryReferenceTypeBranch = "branch"
+	fluxRepositoryReferenceTypeCommit = "commit"
+	fluxRepositoryReferenceTypeSemver = "semver"
+	fluxRepositoryReferenceTypeTag    = "tag"
+)
+
+func resourceKubernetesFluxConfiguration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceKubernetesFluxConfigurationCreateUpdate,
+		Read:   resourceKubernetesFluxConfigurationRead,
+		Update: resourceKubernetesFluxConfigurationCreateUpdate,
+		Delete: resourceKubernetesFluxConfigurationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := fluxconfiguration.ParseFluxConfigurationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: containerValidate.KubernetesFluxConfigurationName,
+			},
+
+			"cluster_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: containerValidate.ClusterID,
+			},
+
+			"namespace": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"git_repository": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"url": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"reference_type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								fluxRepositoryReferenceTypeBranch,
+								fluxRepositoryReferenceTypeCommit,
+								fluxRepositoryReferenceTypeSemver,
+								fluxRepositoryReferenceTypeTag,
+							}, false),
+						},
+
+						"reference_value": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"https_ca_cert_base64": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsBase64,
+						},
+
+						"https_key_base64": {
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							Sensitive:     true,
+							ValidateFunc:  validation.StringIsBase64,
+							RequiredWith:  []string{"git_repository.0.https_user"},
+							ConflictsWith: []string{"git_repository.0.ssh_private_key_base64", "git_repository.0.local_auth_reference"},
+						},
+
+						"https_user": {
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							ValidateFunc:  validation.StringIsNotEmpty,
+							RequiredWith:  []string{"git_repository.0.https_key_base64"},
+							ConflictsWith: []string{"git_repository.0.ssh_private_key_base64", "git_repository.0.local_auth_reference"},
+						},
+
+						"local_auth_reference": {
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							ValidateFunc:  validation.StringIsNotEmpty,
+							ConflictsWith: []string{"git_repository.0.https_user", "git_repository.0.ssh_private_key_base64"},
+						},
+
+						"ssh_known_hosts_base64": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsBase64,
+						},
+
+						"ssh_private_key_base64": {
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							Sensitive:     true,
+							ValidateFunc:  validation.StringIsBase64,
+							ConflictsWith: []string{"git_repository.0.https_user", "git_repository.0.local_auth_reference"},
+						},
+
+						"sync_interval_in_seconds": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: validation.IntAtLeast(60),
+						},
+
+						"timeout_in_seconds": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: validation.IntAtLeast(60),
+						},
+					},
+				},
+			},
+
+			"kustomizations": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"path": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"depends_on": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"garbage_collection_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"recreating_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"retry_interval_in_seconds": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: validation.IntAtLeast(60),
+						},
+
+						"sync_interval_in_seconds": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: validation.IntAtLeast(60),
+						},
+
+						"timeout_in_seconds": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: validation.IntAtLeast(60),
+						},
+					},
+				},
+			},
+
+			"scope": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(fluxconfiguration.ScopeTypeNamespace),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(fluxconfiguration.ScopeTypeCluster),
+					string(fluxconfiguration.ScopeTypeNamespace),
+				}, false),
+			},
+
+			"continuous_reconciliation_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"compliance_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKubernetesFluxConfigurationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.FluxConfigurationClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	clusterId, err := parse.ClusterID(d.Get("cluster_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := fluxconfiguration.NewFluxConfigurationID(clusterId.SubscriptionId, clusterId.ResourceGroup, clusterId.ManagedClusterName, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_kubernetes_flux_configuration", id.ID())
+		}
+	}
+
+	gitRepository, protectedSettings, err := expandKubernetesFluxConfigurationGitRepository(d.Get("git_repository").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	scope := fluxconfiguration.ScopeType(d.Get("scope").(string))
+	sourceKind := fluxconfiguration.SourceKindTypeGitRepository
+	parameters := fluxconfiguration.FluxConfiguration{
+		Properties: &fluxconfiguration.FluxConfigurationProperties{
+			ConfigurationProtectedSettings: protectedSettings,
+			GitRepository:                  gitRepository,
+			Kustomizations:                 expandKubernetesFluxConfigurationKustomizations(d.Get("kustomizations").(*pluginsdk.Set).List()),
+			Namespace:                      utils.String(d.Get("namespace").(string)),
+			Scope:                          &scope,
+			SourceKind:                     &sourceKind,
+			Suspend:                        utils.Bool(!d.Get("continuous_reconciliation_enabled").(bool)),
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceKubernetesFluxConfigurationRead(d, meta)
+}
+
+func resourceKubernetesFluxConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.FluxConfigurationClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := fluxconfiguration.ParseFluxConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.FluxConfigurationName)
+	d.Set("cluster_id", parse.NewClusterID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("namespace", props.Namespace)
+
+			scope := ""
+			if props.Scope != nil {
+				scope = string(*props.Scope)
+			}
+			d.Set("scope", scope)
+
+			complianceState := ""
+			if props.ComplianceState != nil {
+				complianceState = string(*props.ComplianceState)
+			}
+			d.Set("compliance_state", complianceState)
+
+			suspended := false
+			if props.Suspend != nil {
+				suspended = *props.Suspend
+			}
+			d.Set("continuous_reconciliation_enabled", !suspended)
+
+			if err := d.Set("git_repository", flattenKubernetesFluxConfigurationGitRepository(props.GitRepository, d.Get("git_repository").([]interface{}))); err != nil {
+				return fmt.Errorf("setting `git_repository`: %+v", err)
+			}
+
+			if err := d.Set("kustomizations", flattenKubernetesFluxConfigurationKustomizations(props.Kustomizations)); err != nil {
+				return fmt.Errorf("setting `kustomizations`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceKubernetesFluxConfigurationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Containers.FluxConfigurationClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := fluxconfiguration.ParseFluxConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id, fluxconfiguration.DefaultDeleteOptions()); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandKubernetesFluxConfigurationGitRepository(input []interface{}) (*fluxconfiguration.GitRepositoryDefinition, *map[string]string, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := fluxconfiguration.GitRepositoryDefinition{
+		RepositoryRef:         &fluxconfiguration.RepositoryRefDefinition{},
+		SyncIntervalInSeconds: utils.Int64(int64(raw["sync_interval_in_seconds"].(int))),
+		TimeoutInSeconds:      utils.Int64(int64(raw["timeout_in_seconds"].(int))),
+		Url:                   utils.String(raw["url"].(string)),
+	}
+
+	referenceValue := raw["reference_value"].(string)
+	switch raw["reference_type"].(string) {
+	case fluxRepositoryReferenceTypeBranch:
+		output.RepositoryRef.Branch = utils.String(referenceValue)
+	case fluxRepositoryReferenceTypeCommit:
+		output.RepositoryRef.Commit = utils.String(referenceValue)
+	case fluxRepositoryReferenceTypeSemver:
+		output.RepositoryRef.Semver = utils.String(referenceValue)
+	case fluxRepositoryReferenceTypeTag:
+		output.RepositoryRef.Tag = utils.String(referenceValue)
+	default:
+		return nil, nil, fmt.Errorf("unsupported `reference_type` %q", raw["reference_type"].(string))
+	}
+
+	if v := raw["https_ca_cert_base64"].(string); v != "" {
+		output.HttpsCACert = utils.String(v)
+	}
+	if v := raw["https_user"].(string); v != "" {
+		output.HttpsUser = utils.String(v)
+	}
+	if v := raw["local_auth_reference"].(string); v != "" {
+		output.LocalAuthRef = utils.String(v)
+	}
+	if v := raw["ssh_known_hosts_base64"].(string); v != "" {
+		output.SshKnownHosts = utils.String(v)
+	}
+
+	protectedSettings := make(map[string]string)
+	if v := raw["https_key_base64"].(string); v != "" {
+		protectedSettings["httpsKey"] = v
+	}
+	if v := raw["ssh_private_key_base64"].(string); v != "" {
+		protectedSettings["sshPrivateKey"] = v
+	}
+
+	return &output, &protectedSettings, nil
+}
+
+func expandKubernetesFluxConfigurationKustomizations(input []interface{}) *map[string]fluxconfiguration.KustomizationDefinition {
+	output := make(map[string]fluxconfiguration.KustomizationDefinition)
+	for _, item := range input {
+		raw := item.(map[string]interface{})
+
+		name := raw["name"].(string)
+		output[name] = fluxconfiguration.KustomizationDefinition{
+			DependsOn:              utils.ExpandStringSlice(raw["depends_on"].([]interface{})),
+			Force:                  utils.Bool(raw["recreating_enabled"].(bool)),
+			Path:                   utils.String(raw["path"].(string)),
+			Prune:                  utils.Bool(raw["garbage_collection_enabled"].(bool)),
+			RetryIntervalInSeconds: utils.Int64(int64(raw["retry_interval_in_seconds"].(int))),
+			SyncIntervalInSeconds:  utils.Int64(int64(raw["sync_interval_in_seconds"].(int))),
+			TimeoutInSeconds:       utils.Int64(int64(raw["timeout_in_seconds"].(int))),
+		}
+	}
+	return &output
+}
+
+func flattenKubernetesFluxConfigurationGitRepository(input *fluxconfiguration.GitRepositoryDefinition, existing []interface{}) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	// the API doesn't return the protected settings, so these are retained from the config
+	httpsKey := ""
+	sshPrivateKey := ""
+	if len(existing) > 0 && existing[0] != nil {
+		raw := existing[0].(map[string]interface{})
+		httpsKey = raw["https_key_base64"].(string)
+		sshPrivateKey = raw["ssh_private_key_base64"].(string)
+	}
+
+	referenceType := ""
+	referenceValue := ""
+	if ref := input.RepositoryRef; ref != nil {
+		switch {
+		case ref.Branch != nil:
+			referenceType = fluxRepositoryReferenceTypeBranch
+			referenceValue = *ref.Branch
+		case ref.Commit != nil:
+			referenceType = fluxRepositoryReferenceTypeCommit
+			referenceValue = *ref.Commit
+		case ref.Semver != nil:
+			referenceType = fluxRepositoryReferenceTypeSemver
+			referenceValue = *ref.Semver
+		case ref.Tag != nil:
+			referenceType = fluxRepositoryReferenceTypeTag
+			referenceValue = *ref.Tag
+		}
+	}
+
+	httpsCACert := ""
+	if input.HttpsCACert != nil {
+		httpsCACert = *input.HttpsCACert
+	}
+
+	httpsUser := ""
+	if input.HttpsUser != nil {
+		httpsUser = *input.HttpsUser
+	}
+
+	localAuthReference := ""
+	if input.LocalAuthRef != nil {
+		localAuthReference = *input.LocalAuthRef
+	}
+
+	sshKnownHosts := ""
+	if input.SshKnownHosts != nil {
+		sshKnownHosts = *input.SshKnownHosts
+	}
+
+	syncInterval := 0
+	if input.SyncIntervalInSeconds != nil {
+		syncInterval = int(*input.SyncIntervalInSeconds)
+	}
+
+	timeout := 0
+	if input.TimeoutInSeconds != nil {
+		timeout = int(*input.TimeoutInSeconds)
+	}
+
+	url := ""
+	if input.Url != nil {
+		url = *input.Url
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"https_ca_cert_base64":     httpsCACert,
+			"https_key_base64":         httpsKey,
+			"https_user":               httpsUser,
+			"local_auth_reference":     localAuthReference,
+			"reference_type":           referenceType,
+			"reference_value":          referenceValue,
+			"ssh_known_hosts_base64":   sshKnownHosts,
+			"ssh_private_key_base64":   sshPrivateKey,
+			"sync_interval_in_seconds": syncInterval,
+			"timeout_in_seconds":       timeout,
+			"url":                      url,
+		},
+	}
+}
+
+func flattenKubernetesFluxConfigurationKustomizations(input *map[string]fluxconfiguration.KustomizationDefinition) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for name, v := range *input {
+		force := false
+		if v.Force != nil {
+			force = *v.Force
+		}
+
+		path := ""
+		if v.Path != nil {
+			path = *v.Path
+		}
+
+		prune := false
+		if v.Prune != nil {
+			prune = *v.Prune
+		}
+
+		retryInterval := 0
+		if v.RetryIntervalInSeconds != nil {
+			retryInterval = int(*v.RetryIntervalInSeconds)
+		}
+
+		syncInterval := 0
+		if v.SyncIntervalInSeconds != nil {
+			syncInterval = int(*v.SyncIntervalInSeconds)
+		}
+
+		timeout := 0
+		if v.TimeoutInSeconds != nil {
+			timeout = int(*v.TimeoutInSeconds)
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":                       name,
+			"path":                       path,
+			"depends_on":                 utils.FlattenStringSlice(v.DependsOn),
+			"garbage_collection_enabled": prune,
+			"recreating_enabled":         force,
+			"retry_interval_in_seconds":  retryInterval,
+			"sync_interval_in_seconds":   syncInterval,
+			"timeout_in_seconds":         timeout,
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ContainerRegistryRunId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	RegistryName   string
+	RunName        string
+}
+
+func NewContainerRegistryRunID(subscriptionId, resourceGroup, registryName, runName string) ContainerRegistryRunId {
+	return ContainerRegistryRunId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		RegistryName:   registryName,
+		RunName:        runName,
+	}
+}
+
+func (id ContainerRegistryRunId) String() string {
+	segments := []string{
+		fmt.Sprintf("Run Name %q", id.RunName),
+		fmt.Sprintf("Registry Name %q", id.RegistryName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Container Registry Run", segmentsStr)
+}
+
+func (id ContainerRegistryRunId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerRegistry/registries/%s/runs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.RunName)
+}
+
+// ContainerRegistryRunID parses a ContainerRegistryRun ID into an ContainerRegistryRunId struct
+func ContainerRegistryRunID(input string) (*ContainerRegistryRunId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ContainerRegistryRunId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.RegistryName, err = id.PopSegment("registries"); err != nil {
+		return nil, err
+	}
+	if resourceId.RunName, err = id.PopSegment("runs"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,15 @@
+package extensions
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ExtensionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewExtensionsClientWithBaseURI(endpoint string) ExtensionsClient {
+	return ExtensionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,7 @@
+package extensions
+
+type ErrorDetail struct {
+	Code    *string `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+	Target  *string `json:"target,omitempty"`
+}
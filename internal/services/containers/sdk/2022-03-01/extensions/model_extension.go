@@ -0,0 +1,8 @@
+package extensions
+
+type Extension struct {
+	Id         *string              `json:"id,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+	Properties *ExtensionProperties `json:"properties,omitempty"`
+	Type       *string              `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package extensions
+
+type PatchExtensionProperties struct {
+	AutoUpgradeMinorVersion        *bool              `json:"autoUpgradeMinorVersion,omitempty"`
+	ConfigurationProtectedSettings *map[string]string `json:"configurationProtectedSettings,omitempty"`
+	ConfigurationSettings          *map[string]string `json:"configurationSettings,omitempty"`
+	ReleaseTrain                   *string            `json:"releaseTrain,omitempty"`
+	Version                        *string            `json:"version,omitempty"`
+}
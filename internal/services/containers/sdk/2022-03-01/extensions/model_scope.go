@@ -0,0 +1,6 @@
+package extensions
+
+type Scope struct {
+	Cluster   *ScopeCluster   `json:"cluster,omitempty"`
+	Namespace *ScopeNamespace `json:"namespace,omitempty"`
+}
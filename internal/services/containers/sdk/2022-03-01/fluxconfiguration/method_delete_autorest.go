@@ -0,0 +1,95 @@
+package fluxconfiguration
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type DeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+type DeleteOptions struct {
+	ForceDelete *bool
+}
+
+func DefaultDeleteOptions() DeleteOptions {
+	return DeleteOptions{}
+}
+
+func (o DeleteOptions) toQueryString() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	if o.ForceDelete != nil {
+		out["forceDelete"] = *o.ForceDelete
+	}
+
+	return out
+}
+
+// Delete ...
+func (c FluxConfigurationClient) Delete(ctx context.Context, id FluxConfigurationId, options DeleteOptions) (result DeleteResponse, err error) {
+	req, err := c.preparerForDelete(ctx, id, options)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "fluxconfiguration.FluxConfigurationClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "fluxconfiguration.FluxConfigurationClient", "Delete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c FluxConfigurationClient) DeleteThenPoll(ctx context.Context, id FluxConfigurationId, options DeleteOptions) error {
+	result, err := c.Delete(ctx, id, options)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForDelete prepares the Delete request.
+func (c FluxConfigurationClient) preparerForDelete(ctx context.Context, id FluxConfigurationId, options DeleteOptions) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	for k, v := range options.toQueryString() {
+		queryParameters[k] = autorest.Encode("query", v)
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForDelete sends the Delete request. The method will close the
+// http.Response Body if it receives an error.
+func (c FluxConfigurationClient) senderForDelete(ctx context.Context, req *http.Request) (future DeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
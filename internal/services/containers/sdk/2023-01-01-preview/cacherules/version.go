@@ -0,0 +1,9 @@
+package cacherules
+
+import "fmt"
+
+const defaultApiVersion = "2023-01-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/cacherules/%s", defaultApiVersion)
+}
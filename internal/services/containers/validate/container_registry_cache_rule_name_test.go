@@ -0,0 +1,43 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+)
+
+func TestContainerRegistryCacheRuleName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "four",
+			ErrCount: 1,
+		},
+		{
+			Value:    "cache-rule",
+			ErrCount: 0,
+		},
+		{
+			Value:    "cache_rule",
+			ErrCount: 1,
+		},
+		{
+			Value:    "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			ErrCount: 0,
+		},
+		{
+			Value:    "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validate.ContainerRegistryCacheRuleName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %q but got %d: %v", tc.ErrCount, tc.Value, len(errors), errors)
+		}
+	}
+}
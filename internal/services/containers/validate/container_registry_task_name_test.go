@@ -0,0 +1,59 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+)
+
+func TestContainerRegistryTaskName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "four",
+			ErrCount: 1,
+		},
+		{
+			Value:    "5five",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hello-world",
+			ErrCount: 0,
+		},
+		{
+			Value:    "hello_world",
+			ErrCount: 0,
+		},
+		{
+			Value:    "_hello",
+			ErrCount: 1,
+		},
+		{
+			Value:    "-hello",
+			ErrCount: 1,
+		},
+		{
+			Value:    "hello@world",
+			ErrCount: 1,
+		},
+		{
+			Value:    "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			ErrCount: 0,
+		},
+		{
+			Value:    "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validate.ContainerRegistryTaskName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %q but got %d: %v", tc.ErrCount, tc.Value, len(errors), errors)
+		}
+	}
+}
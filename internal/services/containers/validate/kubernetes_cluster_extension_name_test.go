@@ -0,0 +1,64 @@
+package validate_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+)
+
+func TestKubernetesClusterExtensionName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 2,
+		},
+		{
+			Value:    "flux",
+			ErrCount: 0,
+		},
+		{
+			Value:    "microsoft.flux",
+			ErrCount: 0,
+		},
+		{
+			Value:    "dapr-1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Dapr",
+			ErrCount: 1,
+		},
+		{
+			Value:    "-dapr",
+			ErrCount: 1,
+		},
+		{
+			Value:    "dapr.",
+			ErrCount: 1,
+		},
+		{
+			Value:    "dapr_1",
+			ErrCount: 1,
+		},
+		{
+			Value:    strings.Repeat("a", 253),
+			ErrCount: 0,
+		},
+		{
+			Value:    strings.Repeat("a", 254),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validate.KubernetesClusterExtensionName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %q but got %d: %v", tc.ErrCount, tc.Value, len(errors), errors)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func KubernetesFluxConfigurationName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if len(value) < 1 || len(value) > 30 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 30 characters in length", k))
+	}
+
+	if !regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q may only contain lowercase alphanumeric characters and dashes, and must start and end with an alphanumeric character", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,56 @@
+package validate_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+)
+
+func TestKubernetesFluxConfigurationName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 2,
+		},
+		{
+			Value:    "gitops",
+			ErrCount: 0,
+		},
+		{
+			Value:    "cluster-config-1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "Cluster-Config",
+			ErrCount: 1,
+		},
+		{
+			Value:    "cluster.config",
+			ErrCount: 1,
+		},
+		{
+			Value:    "cluster-config-",
+			ErrCount: 1,
+		},
+		{
+			Value:    strings.Repeat("a", 30),
+			ErrCount: 0,
+		},
+		{
+			Value:    strings.Repeat("a", 31),
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validate.KubernetesFluxConfigurationName(tc.Value, "name")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for %q but got %d: %v", tc.ErrCount, tc.Value, len(errors), errors)
+		}
+	}
+}
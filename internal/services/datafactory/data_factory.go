@@ -520,38 +520,23 @@ func flattenDataFactoryDatasetSFTPLocation(input *datafactory.SftpLocation) []in
 	return []interface{}{result}
 }
 
-func flattenDataFactoryDatasetCompression(input datafactory.BasicDatasetCompression) []interface{} {
+func flattenDataFactoryDatasetCompression(input *datafactory.DatasetCompression) []interface{} {
 	if input == nil {
 		return nil
 	}
 	result := make(map[string]interface{})
 
-	if compression, ok := input.AsDatasetBZip2Compression(); ok {
-		result["type"] = compression.Type
+	if v, ok := input.Type.(string); ok {
+		result["type"] = v
 	}
-	if compression, ok := input.AsDatasetDeflateCompression(); ok {
-		result["type"] = compression.Type
-	}
-	if compression, ok := input.AsDatasetGZipCompression(); ok {
-		result["type"] = compression.Type
-		result["level"] = compression.Level
-	}
-	if compression, ok := input.AsDatasetTarCompression(); ok {
-		result["type"] = compression.Type
-	}
-	if compression, ok := input.AsDatasetTarGZipCompression(); ok {
-		result["type"] = compression.Type
-		result["level"] = compression.Level
-	}
-	if compression, ok := input.AsDatasetZipDeflateCompression(); ok {
-		result["type"] = compression.Type
-		result["level"] = compression.Level
+	if v, ok := input.Level.(string); ok {
+		result["level"] = v
 	}
 
 	return []interface{}{result}
 }
 
-func expandDataFactoryDatasetCompression(d *pluginsdk.ResourceData) datafactory.BasicDatasetCompression {
+func expandDataFactoryDatasetCompression(d *pluginsdk.ResourceData) *datafactory.DatasetCompression {
 	compression := d.Get("compression").([]interface{})
 	if len(compression) == 0 || compression[0] == nil {
 		return nil
@@ -560,39 +545,15 @@ func expandDataFactoryDatasetCompression(d *pluginsdk.ResourceData) datafactory.
 	level := props["level"].(string)
 	compressionType := props["type"].(string)
 
-	if datafactory.TypeBasicDatasetCompression(compressionType) == datafactory.TypeBasicDatasetCompressionTypeBZip2 {
-		return datafactory.DatasetBZip2Compression{
-			Type: datafactory.TypeBasicDatasetCompression(compressionType),
-		}
-	}
-	if datafactory.TypeBasicDatasetCompression(compressionType) == datafactory.TypeBasicDatasetCompressionTypeDeflate {
-		return datafactory.DatasetDeflateCompression{
-			Type: datafactory.TypeBasicDatasetCompression(compressionType),
-		}
-	}
-	if datafactory.TypeBasicDatasetCompression(compressionType) == datafactory.TypeBasicDatasetCompressionTypeGZip {
-		return datafactory.DatasetGZipCompression{
-			Type:  datafactory.TypeBasicDatasetCompression(compressionType),
-			Level: level,
-		}
-	}
-	if datafactory.TypeBasicDatasetCompression(compressionType) == datafactory.TypeBasicDatasetCompressionTypeTar {
-		return datafactory.DatasetTarCompression{
-			Type: datafactory.TypeBasicDatasetCompression(compressionType),
-		}
-	}
-	if datafactory.TypeBasicDatasetCompression(compressionType) == datafactory.TypeBasicDatasetCompressionTypeTarGZip {
-		return datafactory.DatasetTarGZipCompression{
-			Type:  datafactory.TypeBasicDatasetCompression(compressionType),
-			Level: level,
-		}
+	result := datafactory.DatasetCompression{
+		Type: compressionType,
 	}
-	if datafactory.TypeBasicDatasetCompression(compressionType) == datafactory.TypeBasicDatasetCompressionTypeZipDeflate {
-		return datafactory.DatasetZipDeflateCompression{
-			Type:  datafactory.TypeBasicDatasetCompression(compressionType),
-			Level: level,
-		}
+
+	// the compression level is only supported by the GZip, TarGZip and ZipDeflate compression types
+	switch compressionType {
+	case "GZip", "TarGZip", "ZipDeflate":
+		result.Level = level
 	}
 
-	return nil
+	return &result
 }
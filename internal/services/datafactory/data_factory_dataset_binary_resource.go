@@ -232,12 +232,12 @@ func resourceDataFactoryDatasetBinary() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(datafactory.TypeBasicDatasetCompressionTypeBZip2),
-								string(datafactory.TypeBasicDatasetCompressionTypeDeflate),
-								string(datafactory.TypeBasicDatasetCompressionTypeGZip),
-								string(datafactory.TypeBasicDatasetCompressionTypeTar),
-								string(datafactory.TypeBasicDatasetCompressionTypeTarGZip),
-								string(datafactory.TypeBasicDatasetCompressionTypeZipDeflate),
+								"BZip2",
+								"Deflate",
+								"GZip",
+								"Tar",
+								"TarGZip",
+								"ZipDeflate",
 							}, false),
 						},
 					},
@@ -0,0 +1,280 @@
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type FirewallPolicyApplicationRuleCollectionResource struct {
+}
+
+func TestAccFirewallPolicyApplicationRuleCollection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_application_rule_collection", "test")
+	r := FirewallPolicyApplicationRuleCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyApplicationRuleCollection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_application_rule_collection", "test")
+	r := FirewallPolicyApplicationRuleCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyApplicationRuleCollection_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_application_rule_collection", "test")
+	r := FirewallPolicyApplicationRuleCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_firewall_policy_application_rule_collection.second").ExistsInAzure(r),
+				check.That("azurerm_firewall_policy_network_rule_collection.test").ExistsInAzure(FirewallPolicyNetworkRuleCollectionResource{}),
+			),
+		},
+		data.ImportStep(),
+		data.ImportStepFor("azurerm_firewall_policy_application_rule_collection.second"),
+		data.ImportStepFor("azurerm_firewall_policy_network_rule_collection.test"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyApplicationRuleCollection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_application_rule_collection", "test")
+	r := FirewallPolicyApplicationRuleCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (FirewallPolicyApplicationRuleCollectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	return firewallPolicyRuleCollectionExists(ctx, clients, state)
+}
+
+func firewallPolicyRuleCollectionExists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FirewallPolicyRuleCollectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Firewall.FirewallPolicyRuleGroupClient.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %v", id.String(), err)
+	}
+
+	if props := resp.FirewallPolicyRuleCollectionGroupProperties; props != nil && props.RuleCollections != nil {
+		for _, v := range *props.RuleCollections {
+			if collection, ok := v.AsFirewallPolicyFilterRuleCollection(); ok && collection != nil && collection.Name != nil && *collection.Name == id.RuleCollectionName {
+				return utils.Bool(true), nil
+			}
+			if collection, ok := v.AsFirewallPolicyNatRuleCollection(); ok && collection != nil && collection.Name != nil && *collection.Name == id.RuleCollectionName {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (FirewallPolicyApplicationRuleCollectionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-RC-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-RC-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id = azurerm_firewall_policy.test.id
+  priority           = 500
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r FirewallPolicyApplicationRuleCollectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_application_rule_collection" "test" {
+  name                     = "app_rule_collection1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  priority                 = 500
+  action                   = "Deny"
+
+  rule {
+    name = "app_rule_collection1_rule1"
+    protocols {
+      type = "Https"
+      port = 443
+    }
+    source_addresses  = ["10.0.0.1"]
+    destination_fqdns = ["pluginsdk.io"]
+  }
+}
+`, r.template(data))
+}
+
+func (r FirewallPolicyApplicationRuleCollectionResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_application_rule_collection" "test" {
+  name                     = "app_rule_collection1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  priority                 = 600
+  action                   = "Allow"
+
+  rule {
+    name = "app_rule_collection1_rule1"
+    protocols {
+      type = "Http"
+      port = 80
+    }
+    protocols {
+      type = "Https"
+      port = 443
+    }
+    source_addresses  = ["10.0.0.1", "10.0.0.2"]
+    destination_fqdns = ["pluginsdk.io"]
+  }
+
+  rule {
+    name        = "app_rule_collection1_rule2"
+    description = "Allow Windows Diagnostics"
+    protocols {
+      type = "Https"
+      port = 443
+    }
+    source_addresses      = ["10.0.0.1"]
+    destination_fqdn_tags = ["WindowsDiagnostics"]
+  }
+}
+`, r.template(data))
+}
+
+func (r FirewallPolicyApplicationRuleCollectionResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_application_rule_collection" "second" {
+  name                     = "app_rule_collection2"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  priority                 = 300
+  action                   = "Allow"
+
+  rule {
+    name = "app_rule_collection2_rule1"
+    protocols {
+      type = "Https"
+      port = 443
+    }
+    source_addresses  = ["10.0.0.2"]
+    destination_fqdns = ["pluginsdk.io"]
+  }
+}
+
+resource "azurerm_firewall_policy_network_rule_collection" "test" {
+  name                     = "network_rule_collection1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  priority                 = 400
+  action                   = "Deny"
+
+  rule {
+    name                  = "network_rule_collection1_rule1"
+    protocols             = ["TCP"]
+    source_addresses      = ["10.0.0.1"]
+    destination_addresses = ["192.168.1.1"]
+    destination_ports     = ["80"]
+  }
+}
+`, r.basic(data))
+}
+
+func (r FirewallPolicyApplicationRuleCollectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_application_rule_collection" "import" {
+  name                     = azurerm_firewall_policy_application_rule_collection.test.name
+  rule_collection_group_id = azurerm_firewall_policy_application_rule_collection.test.rule_collection_group_id
+  priority                 = azurerm_firewall_policy_application_rule_collection.test.priority
+  action                   = azurerm_firewall_policy_application_rule_collection.test.action
+
+  rule {
+    name = "app_rule_collection1_rule1"
+    protocols {
+      type = "Https"
+      port = 443
+    }
+    source_addresses  = ["10.0.0.1"]
+    destination_fqdns = ["pluginsdk.io"]
+  }
+}
+`, r.basic(data))
+}
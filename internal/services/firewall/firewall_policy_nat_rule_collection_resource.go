@@ -0,0 +1,49 @@
+package firewall
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func resourceFirewallPolicyNatRuleCollection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceFirewallPolicyNatRuleCollectionCreateUpdate,
+		Read:   resourceFirewallPolicyNatRuleCollectionRead,
+		Update: resourceFirewallPolicyNatRuleCollectionCreateUpdate,
+		Delete: resourceFirewallPolicyRuleCollectionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FirewallPolicyRuleCollectionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: firewallPolicyRuleCollectionSchema([]string{
+			// Hardcoded to `Dnat` for the same reason as the `nat_rule_collection` block of the Rule Collection Group
+			"Dnat",
+		}, firewallPolicyNatRuleSchema()),
+	}
+}
+
+func resourceFirewallPolicyNatRuleCollectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	collections, err := expandFirewallPolicyRuleCollectionNat(firewallPolicyRuleCollectionInput(d))
+	if err != nil {
+		return fmt.Errorf("expanding NAT rule collection: %w", err)
+	}
+
+	return resourceFirewallPolicyRuleCollectionCreateUpdate(d, meta, "azurerm_firewall_policy_nat_rule_collection", network.RuleTypeNatRule, collections[0])
+}
+
+func resourceFirewallPolicyNatRuleCollectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	return resourceFirewallPolicyRuleCollectionRead(d, meta, network.RuleTypeNatRule)
+}
@@ -0,0 +1,159 @@
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type FirewallPolicyNatRuleCollectionResource struct {
+}
+
+func TestAccFirewallPolicyNatRuleCollection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_nat_rule_collection", "test")
+	r := FirewallPolicyNatRuleCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyNatRuleCollection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_nat_rule_collection", "test")
+	r := FirewallPolicyNatRuleCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyNatRuleCollection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_nat_rule_collection", "test")
+	r := FirewallPolicyNatRuleCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (FirewallPolicyNatRuleCollectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	return firewallPolicyRuleCollectionExists(ctx, clients, state)
+}
+
+func (r FirewallPolicyNatRuleCollectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_nat_rule_collection" "test" {
+  name                     = "nat_rule_collection1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  priority                 = 400
+  action                   = "Dnat"
+
+  rule {
+    name                = "nat_rule_collection1_rule1"
+    protocols           = ["TCP", "UDP"]
+    source_addresses    = ["10.0.0.1", "10.0.0.2"]
+    destination_address = "192.168.1.1"
+    destination_ports   = ["80"]
+    translated_address  = "192.168.0.1"
+    translated_port     = "8080"
+  }
+}
+`, FirewallPolicyApplicationRuleCollectionResource{}.template(data))
+}
+
+func (r FirewallPolicyNatRuleCollectionResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_nat_rule_collection" "test" {
+  name                     = "nat_rule_collection1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  priority                 = 450
+  action                   = "Dnat"
+
+  rule {
+    name                = "nat_rule_collection1_rule1"
+    protocols           = ["TCP"]
+    source_addresses    = ["10.0.0.1"]
+    destination_address = "192.168.1.1"
+    destination_ports   = ["443"]
+    translated_address  = "192.168.0.1"
+    translated_port     = "8443"
+  }
+
+  rule {
+    name                = "nat_rule_collection1_rule2"
+    protocols           = ["TCP", "UDP"]
+    source_addresses    = ["10.0.0.1", "10.0.0.2"]
+    destination_address = "192.168.1.1"
+    destination_ports   = ["80"]
+    translated_fqdn     = "time.microsoft.com"
+    translated_port     = "8080"
+  }
+}
+`, FirewallPolicyApplicationRuleCollectionResource{}.template(data))
+}
+
+func (r FirewallPolicyNatRuleCollectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_nat_rule_collection" "import" {
+  name                     = azurerm_firewall_policy_nat_rule_collection.test.name
+  rule_collection_group_id = azurerm_firewall_policy_nat_rule_collection.test.rule_collection_group_id
+  priority                 = azurerm_firewall_policy_nat_rule_collection.test.priority
+  action                   = azurerm_firewall_policy_nat_rule_collection.test.action
+
+  rule {
+    name                = "nat_rule_collection1_rule1"
+    protocols           = ["TCP", "UDP"]
+    source_addresses    = ["10.0.0.1", "10.0.0.2"]
+    destination_address = "192.168.1.1"
+    destination_ports   = ["80"]
+    translated_address  = "192.168.0.1"
+    translated_port     = "8080"
+  }
+}
+`, r.basic(data))
+}
@@ -0,0 +1,45 @@
+package firewall
+
+import (
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func resourceFirewallPolicyNetworkRuleCollection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceFirewallPolicyNetworkRuleCollectionCreateUpdate,
+		Read:   resourceFirewallPolicyNetworkRuleCollectionRead,
+		Update: resourceFirewallPolicyNetworkRuleCollectionCreateUpdate,
+		Delete: resourceFirewallPolicyRuleCollectionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FirewallPolicyRuleCollectionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: firewallPolicyRuleCollectionSchema([]string{
+			string(network.FirewallPolicyFilterRuleCollectionActionTypeAllow),
+			string(network.FirewallPolicyFilterRuleCollectionActionTypeDeny),
+		}, firewallPolicyNetworkRuleSchema()),
+	}
+}
+
+func resourceFirewallPolicyNetworkRuleCollectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	collections := expandFirewallPolicyRuleCollectionNetwork(firewallPolicyRuleCollectionInput(d))
+
+	return resourceFirewallPolicyRuleCollectionCreateUpdate(d, meta, "azurerm_firewall_policy_network_rule_collection", network.RuleTypeNetworkRule, collections[0])
+}
+
+func resourceFirewallPolicyNetworkRuleCollectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	return resourceFirewallPolicyRuleCollectionRead(d, meta, network.RuleTypeNetworkRule)
+}
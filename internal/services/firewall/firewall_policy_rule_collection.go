@@ -0,0 +1,348 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// firewallPolicyRuleCollectionSchema returns the schema shared by the standalone Firewall Policy Rule Collection resources
+func firewallPolicyRuleCollectionSchema(actions []string, rule *pluginsdk.Schema) map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"rule_collection_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FirewallPolicyRuleCollectionGroupID,
+		},
+
+		"priority": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(100, 65000),
+		},
+
+		"action": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(actions, false),
+		},
+
+		"rule": rule,
+	}
+}
+
+// firewallPolicyRuleCollectionInput returns the standalone resource's configuration in the shape used by the
+// `*_rule_collection` blocks of the Rule Collection Group, so that the same expand functions can be used for both
+func firewallPolicyRuleCollectionInput(d *pluginsdk.ResourceData) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"name":     d.Get("name").(string),
+			"priority": d.Get("priority").(int),
+			"action":   d.Get("action").(string),
+			"rule":     d.Get("rule").(*pluginsdk.Set),
+		},
+	}
+}
+
+func resourceFirewallPolicyRuleCollectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}, resourceType string, ruleType network.RuleType, collection network.BasicFirewallPolicyRuleCollection) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	groupId, err := parse.FirewallPolicyRuleCollectionGroupID(d.Get("rule_collection_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	priority := int32(d.Get("priority").(int))
+	id := parse.NewFirewallPolicyRuleCollectionID(groupId.SubscriptionId, groupId.ResourceGroup, groupId.FirewallPolicyName, groupId.RuleCollectionGroupName, name)
+
+	if d.IsNewResource() {
+		existing, err := retrieveFirewallPolicyRuleCollection(ctx, client, id)
+		if err != nil {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+
+		if existing != nil {
+			return tf.ImportAsExistsError(resourceType, id.ID())
+		}
+	}
+
+	locks.ByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+	defer locks.UnlockByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+
+	err = updateFirewallPolicyRuleCollections(ctx, client, *groupId, func(collections []network.BasicFirewallPolicyRuleCollection) ([]network.BasicFirewallPolicyRuleCollection, error) {
+		index := -1
+		for i, v := range collections {
+			existingName, existingPriority := firewallPolicyRuleCollectionNameAndPriority(v)
+			if strings.EqualFold(existingName, name) {
+				index = i
+				continue
+			}
+
+			// Azure rejects duplicate priorities within a group, but only once all collections are evaluated - so check up front
+			if existingPriority == priority {
+				return nil, fmt.Errorf("priority %d is already used by Rule Collection %q within %s", priority, existingName, groupId)
+			}
+		}
+
+		if d.IsNewResource() {
+			if index != -1 {
+				return nil, fmt.Errorf("%s was created concurrently", id)
+			}
+
+			return append(collections, collection), nil
+		}
+
+		if index == -1 {
+			return nil, fmt.Errorf("locating %s", id)
+		}
+
+		collections[index] = collection
+		return collections, nil
+	})
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceFirewallPolicyRuleCollectionRead(d, meta, ruleType)
+}
+
+func resourceFirewallPolicyRuleCollectionRead(d *pluginsdk.ResourceData, meta interface{}, ruleType network.RuleType) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyRuleCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	collection, err := retrieveFirewallPolicyRuleCollection(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+	if collection == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+		d.SetId("")
+		return nil
+	}
+
+	applicationRuleCollections, networkRuleCollections, natRuleCollections, err := flattenFirewallPolicyRuleCollection(&[]network.BasicFirewallPolicyRuleCollection{collection})
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", *id, err)
+	}
+
+	var flattened []interface{}
+	switch ruleType {
+	case network.RuleTypeApplicationRule:
+		flattened = applicationRuleCollections
+	case network.RuleTypeNetworkRule:
+		flattened = networkRuleCollections
+	case network.RuleTypeNatRule:
+		flattened = natRuleCollections
+	}
+	if len(flattened) == 0 {
+		return fmt.Errorf("%s doesn't contain rules of type %q", *id, string(ruleType))
+	}
+	values := flattened[0].(map[string]interface{})
+
+	d.Set("name", id.RuleCollectionName)
+	d.Set("rule_collection_group_id", parse.NewFirewallPolicyRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName).ID())
+	d.Set("priority", values["priority"])
+	d.Set("action", values["action"])
+
+	if err := d.Set("rule", values["rule"]); err != nil {
+		return fmt.Errorf("setting `rule`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceFirewallPolicyRuleCollectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyRuleCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	groupId := parse.NewFirewallPolicyRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+
+	locks.ByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+	defer locks.UnlockByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+
+	err = updateFirewallPolicyRuleCollections(ctx, client, groupId, func(collections []network.BasicFirewallPolicyRuleCollection) ([]network.BasicFirewallPolicyRuleCollection, error) {
+		output := make([]network.BasicFirewallPolicyRuleCollection, 0)
+		for _, v := range collections {
+			if name, _ := firewallPolicyRuleCollectionNameAndPriority(v); strings.EqualFold(name, id.RuleCollectionName) {
+				continue
+			}
+			output = append(output, v)
+		}
+		return output, nil
+	})
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// retrieveFirewallPolicyRuleCollection returns the Rule Collection with the specified ID, or nil if either
+// the Rule Collection or the Rule Collection Group containing it no longer exists
+func retrieveFirewallPolicyRuleCollection(ctx context.Context, client *network.FirewallPolicyRuleCollectionGroupsClient, id parse.FirewallPolicyRuleCollectionId) (network.BasicFirewallPolicyRuleCollection, error) {
+	group, err := client.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(group.Response) {
+			log.Printf("[DEBUG] Firewall Policy Rule Collection Group %q was not found in Resource Group %q", id.RuleCollectionGroupName, id.ResourceGroup)
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("retrieving Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): %+v", id.RuleCollectionGroupName, id.ResourceGroup, id.FirewallPolicyName, err)
+	}
+
+	if props := group.FirewallPolicyRuleCollectionGroupProperties; props != nil && props.RuleCollections != nil {
+		for _, v := range *props.RuleCollections {
+			if name, _ := firewallPolicyRuleCollectionNameAndPriority(v); strings.EqualFold(name, id.RuleCollectionName) {
+				return v, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// updateFirewallPolicyRuleCollections applies `modify` to the Rule Collections within the specified Rule Collection Group.
+func updateFirewallPolicyRuleCollections(ctx context.Context, client *network.FirewallPolicyRuleCollectionGroupsClient, id parse.FirewallPolicyRuleCollectionGroupId, modify func([]network.BasicFirewallPolicyRuleCollection) ([]network.BasicFirewallPolicyRuleCollection, error)) error {
+	return updateFirewallPolicyRuleCollectionGroup(ctx, client, id, func(props *network.FirewallPolicyRuleCollectionGroupProperties) error {
+		collections := make([]network.BasicFirewallPolicyRuleCollection, 0)
+		if props.RuleCollections != nil {
+			collections = *props.RuleCollections
+		}
+
+		collections, err := modify(collections)
+		if err != nil {
+			return err
+		}
+
+		sortFirewallPolicyRuleCollections(collections)
+		props.RuleCollections = &collections
+		return nil
+	})
+}
+
+// updateFirewallPolicyRuleCollectionGroup applies `modify` to the properties of the specified Rule Collection Group.
+//
+// The locks held by the callers only guard against changes made from within this provider, as such the update is
+// conditional on the ETag of the Rule Collection Group. Should the group have been modified elsewhere in the meantime
+// the group is re-retrieved and `modify` is applied again.
+func updateFirewallPolicyRuleCollectionGroup(ctx context.Context, client *network.FirewallPolicyRuleCollectionGroupsClient, id parse.FirewallPolicyRuleCollectionGroupId, modify func(props *network.FirewallPolicyRuleCollectionGroupProperties) error) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context is missing a timeout")
+	}
+
+	return pluginsdk.Retry(time.Until(deadline), func() *pluginsdk.RetryError {
+		group, err := client.Get(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+		if err != nil {
+			return pluginsdk.NonRetryableError(fmt.Errorf("retrieving %s: %+v", id, err))
+		}
+
+		if group.FirewallPolicyRuleCollectionGroupProperties == nil {
+			return pluginsdk.NonRetryableError(fmt.Errorf("retrieving %s: `properties` was nil", id))
+		}
+
+		if err := modify(group.FirewallPolicyRuleCollectionGroupProperties); err != nil {
+			return pluginsdk.NonRetryableError(err)
+		}
+
+		req, err := client.CreateOrUpdatePreparer(ctx, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName, group)
+		if err != nil {
+			return pluginsdk.NonRetryableError(fmt.Errorf("preparing update for %s: %+v", id, err))
+		}
+		if group.Etag != nil && *group.Etag != "" {
+			req.Header.Set("If-Match", *group.Etag)
+		}
+
+		future, err := client.CreateOrUpdateSender(req)
+		if err != nil {
+			if resp := future.Response(); resp != nil && resp.StatusCode == http.StatusPreconditionFailed {
+				log.Printf("[DEBUG] %s was modified concurrently - retrying..", id)
+				return pluginsdk.RetryableError(fmt.Errorf("updating %s: %+v", id, err))
+			}
+
+			return pluginsdk.NonRetryableError(fmt.Errorf("updating %s: %+v", id, err))
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return pluginsdk.NonRetryableError(fmt.Errorf("waiting for update of %s: %+v", id, err))
+		}
+
+		return nil
+	})
+}
+
+// sortFirewallPolicyRuleCollections orders the Rule Collections by priority (and then name) so that the
+// contents of the Rule Collection Group don't depend on the order in which the Rule Collections were added
+func sortFirewallPolicyRuleCollections(input []network.BasicFirewallPolicyRuleCollection) {
+	sort.SliceStable(input, func(i, j int) bool {
+		iName, iPriority := firewallPolicyRuleCollectionNameAndPriority(input[i])
+		jName, jPriority := firewallPolicyRuleCollectionNameAndPriority(input[j])
+		if iPriority != jPriority {
+			return iPriority < jPriority
+		}
+		return iName < jName
+	})
+}
+
+func firewallPolicyRuleCollectionNameAndPriority(input network.BasicFirewallPolicyRuleCollection) (name string, priority int32) {
+	if collection, ok := input.AsFirewallPolicyFilterRuleCollection(); ok && collection != nil {
+		if collection.Name != nil {
+			name = *collection.Name
+		}
+		if collection.Priority != nil {
+			priority = *collection.Priority
+		}
+		return
+	}
+
+	if collection, ok := input.AsFirewallPolicyNatRuleCollection(); ok && collection != nil {
+		if collection.Name != nil {
+			name = *collection.Name
+		}
+		if collection.Priority != nil {
+			priority = *collection.Priority
+		}
+	}
+
+	return
+}
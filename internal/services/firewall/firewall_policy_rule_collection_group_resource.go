@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
@@ -22,9 +23,9 @@ import (
 
 func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceFirewallPolicyRuleCollectionGroupCreateUpdate,
+		Create: resourceFirewallPolicyRuleCollectionGroupCreate,
 		Read:   resourceFirewallPolicyRuleCollectionGroupRead,
-		Update: resourceFirewallPolicyRuleCollectionGroupCreateUpdate,
+		Update: resourceFirewallPolicyRuleCollectionGroupUpdate,
 		Delete: resourceFirewallPolicyRuleCollectionGroupDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
@@ -84,114 +85,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 								string(network.FirewallPolicyFilterRuleCollectionActionTypeDeny),
 							}, false),
 						},
-						"rule": {
-							Type:     pluginsdk.TypeSet,
-							Required: true,
-							MinItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"description": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"protocols": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Resource{
-											Schema: map[string]*pluginsdk.Schema{
-												"type": {
-													Type:     pluginsdk.TypeString,
-													Required: true,
-													ValidateFunc: validation.StringInSlice([]string{
-														string(network.FirewallPolicyRuleApplicationProtocolTypeHTTP),
-														string(network.FirewallPolicyRuleApplicationProtocolTypeHTTPS),
-													}, false),
-												},
-												"port": {
-													Type:         pluginsdk.TypeInt,
-													Required:     true,
-													ValidateFunc: validation.IntBetween(0, 64000),
-												},
-											},
-										},
-									},
-									"source_addresses": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												validation.IsIPAddress,
-												validation.IsCIDR,
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-									"source_ip_groups": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_addresses": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												validation.IsIPAddress,
-												validation.IsCIDR,
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-									"destination_fqdns": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_urls": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_fqdn_tags": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"terminate_tls": {
-										Type:     pluginsdk.TypeBool,
-										Optional: true,
-									},
-									"web_categories": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-								},
-							},
-						},
+						"rule": firewallPolicyApplicationRuleSchema(),
 					},
 				},
 			},
@@ -220,89 +114,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 								string(network.FirewallPolicyFilterRuleCollectionActionTypeDeny),
 							}, false),
 						},
-						"rule": {
-							Type:     pluginsdk.TypeSet,
-							Required: true,
-							MinItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"protocols": {
-										Type:     pluginsdk.TypeSet,
-										Required: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												string(network.FirewallPolicyRuleNetworkProtocolAny),
-												string(network.FirewallPolicyRuleNetworkProtocolTCP),
-												string(network.FirewallPolicyRuleNetworkProtocolUDP),
-												string(network.FirewallPolicyRuleNetworkProtocolICMP),
-											}, false),
-										},
-									},
-									"source_addresses": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												validation.IsIPAddress,
-												validation.IsCIDR,
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-									"source_ip_groups": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_addresses": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											// Can be IP address, CIDR, "*", or service tag
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_ip_groups": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_fqdns": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_ports": {
-										Type:     pluginsdk.TypeSet,
-										Required: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												azValidate.PortOrPortRangeWithin(1, 65535),
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-								},
-							},
-						},
+						"rule": firewallPolicyNetworkRuleSchema(),
 					},
 				},
 			},
@@ -334,82 +146,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 								"Dnat",
 							}, false),
 						},
-						"rule": {
-							Type:     pluginsdk.TypeSet,
-							Required: true,
-							MinItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"protocols": {
-										Type:     pluginsdk.TypeSet,
-										Required: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												string(network.FirewallPolicyRuleNetworkProtocolTCP),
-												string(network.FirewallPolicyRuleNetworkProtocolUDP),
-											}, false),
-										},
-									},
-									"source_addresses": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												validation.IsIPAddress,
-												validation.IsCIDR,
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-									"source_ip_groups": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_address": {
-										Type:     pluginsdk.TypeString,
-										Optional: true,
-										ValidateFunc: validation.Any(
-											validation.IsIPAddress,
-											validation.IsCIDR,
-										),
-									},
-									"destination_ports": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: azValidate.PortOrPortRangeWithin(1, 64000),
-										},
-									},
-									"translated_address": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.IsIPAddress,
-									},
-									"translated_port": {
-										Type:         pluginsdk.TypeInt,
-										Required:     true,
-										ValidateFunc: validation.IsPortNumber,
-									},
-									"translated_fqdn": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-								},
-							},
-						},
+						"rule": firewallPolicyNatRuleSchema(),
 					},
 				},
 			},
@@ -417,9 +154,9 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 	}
 }
 
-func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+func resourceFirewallPolicyRuleCollectionGroupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
-	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	name := d.Get("name").(string)
@@ -428,40 +165,40 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 		return err
 	}
 
-	if d.IsNewResource() {
-		resp, err := client.Get(ctx, policyId.ResourceGroup, policyId.Name, name)
-		if err != nil {
-			if !utils.ResponseWasNotFound(resp.Response) {
-				return fmt.Errorf("checking for existing Firewall Policy Rule Collection Group %q (Resource Group %q / Policy %q): %+v", name, policyId.ResourceGroup, policyId.Name, err)
-			}
+	resp, err := client.Get(ctx, policyId.ResourceGroup, policyId.Name, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("checking for existing Firewall Policy Rule Collection Group %q (Resource Group %q / Policy %q): %+v", name, policyId.ResourceGroup, policyId.Name, err)
 		}
+	}
 
-		if resp.ID != nil && *resp.ID != "" {
-			return tf.ImportAsExistsError("azurerm_firewall_policy_rule_collection_group", *resp.ID)
-		}
+	if resp.ID != nil && *resp.ID != "" {
+		return tf.ImportAsExistsError("azurerm_firewall_policy_rule_collection_group", *resp.ID)
 	}
 
 	locks.ByName(policyId.Name, azureFirewallPolicyResourceName)
 	defer locks.UnlockByName(policyId.Name, azureFirewallPolicyResourceName)
 
+	rulesCollections, err := expandFirewallPolicyRuleCollectionGroupRuleCollections(d)
+	if err != nil {
+		return err
+	}
+
 	param := network.FirewallPolicyRuleCollectionGroup{
 		FirewallPolicyRuleCollectionGroupProperties: &network.FirewallPolicyRuleCollectionGroupProperties{
-			Priority: utils.Int32(int32(d.Get("priority").(int))),
+			Priority:        utils.Int32(int32(d.Get("priority").(int))),
+			RuleCollections: &rulesCollections,
 		},
 	}
-	var rulesCollections []network.BasicFirewallPolicyRuleCollection
-	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionApplication(d.Get("application_rule_collection").(*pluginsdk.Set).List())...)
-	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionNetwork(d.Get("network_rule_collection").(*pluginsdk.Set).List())...)
 
-	natRules, err := expandFirewallPolicyRuleCollectionNat(d.Get("nat_rule_collection").(*pluginsdk.Set).List())
+	req, err := client.CreateOrUpdatePreparer(ctx, policyId.ResourceGroup, policyId.Name, name, param)
 	if err != nil {
-		return fmt.Errorf("expanding NAT rule collection: %w", err)
+		return fmt.Errorf("preparing creation of Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): %+v", name, policyId.ResourceGroup, policyId.Name, err)
 	}
-	rulesCollections = append(rulesCollections, natRules...)
+	// guard against the Rule Collection Group being created concurrently from elsewhere
+	req.Header.Set("If-None-Match", "*")
 
-	param.FirewallPolicyRuleCollectionGroupProperties.RuleCollections = &rulesCollections
-
-	future, err := client.CreateOrUpdate(ctx, policyId.ResourceGroup, policyId.Name, name, param)
+	future, err := client.CreateOrUpdateSender(req)
 	if err != nil {
 		return fmt.Errorf("creating Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): %+v", name, policyId.ResourceGroup, policyId.Name, err)
 	}
@@ -469,7 +206,7 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 		return fmt.Errorf("waiting Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): %+v", name, policyId.ResourceGroup, policyId.Name, err)
 	}
 
-	resp, err := client.Get(ctx, policyId.ResourceGroup, policyId.Name, name)
+	resp, err = client.Get(ctx, policyId.ResourceGroup, policyId.Name, name)
 	if err != nil {
 		return fmt.Errorf("retrieving Firewall Policy Rule Collection Group %q (Resource Group %q / Policy: %q): %+v", name, policyId.ResourceGroup, policyId.Name, err)
 	}
@@ -485,6 +222,84 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 	return resourceFirewallPolicyRuleCollectionGroupRead(d, meta)
 }
 
+func resourceFirewallPolicyRuleCollectionGroupUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyRuleCollectionGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+	defer locks.UnlockByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+
+	rulesCollections, err := expandFirewallPolicyRuleCollectionGroupRuleCollections(d)
+	if err != nil {
+		return err
+	}
+
+	managedNames := make([]string, 0)
+	for _, key := range []string{"application_rule_collection", "network_rule_collection", "nat_rule_collection"} {
+		o, n := d.GetChange(key)
+		for _, raw := range append(o.(*pluginsdk.Set).List(), n.(*pluginsdk.Set).List()...) {
+			managedNames = append(managedNames, raw.(map[string]interface{})["name"].(string))
+		}
+	}
+
+	err = updateFirewallPolicyRuleCollectionGroup(ctx, client, *id, func(props *network.FirewallPolicyRuleCollectionGroupProperties) error {
+		props.Priority = utils.Int32(int32(d.Get("priority").(int)))
+
+		// when no Rule Collections are defined inline, these are managed by the standalone Rule Collection resources
+		if len(managedNames) == 0 {
+			return nil
+		}
+
+		if props.RuleCollections != nil {
+			unmanagedNames := make([]string, 0)
+			for _, collection := range *props.RuleCollections {
+				name, _ := firewallPolicyRuleCollectionNameAndPriority(collection)
+				managed := false
+				for _, managedName := range managedNames {
+					if strings.EqualFold(name, managedName) {
+						managed = true
+						break
+					}
+				}
+				if !managed {
+					unmanagedNames = append(unmanagedNames, name)
+				}
+			}
+			if len(unmanagedNames) > 0 {
+				return fmt.Errorf("%s contains the Rule Collections %q which aren't managed by this resource - Rule Collections must either be defined inline within `azurerm_firewall_policy_rule_collection_group` or by using the standalone Rule Collection resources, but not both", *id, strings.Join(unmanagedNames, ", "))
+			}
+		}
+
+		props.RuleCollections = &rulesCollections
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceFirewallPolicyRuleCollectionGroupRead(d, meta)
+}
+
+func expandFirewallPolicyRuleCollectionGroupRuleCollections(d *pluginsdk.ResourceData) ([]network.BasicFirewallPolicyRuleCollection, error) {
+	rulesCollections := make([]network.BasicFirewallPolicyRuleCollection, 0)
+	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionApplication(d.Get("application_rule_collection").(*pluginsdk.Set).List())...)
+	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionNetwork(d.Get("network_rule_collection").(*pluginsdk.Set).List())...)
+
+	natRules, err := expandFirewallPolicyRuleCollectionNat(d.Get("nat_rule_collection").(*pluginsdk.Set).List())
+	if err != nil {
+		return nil, fmt.Errorf("expanding NAT rule collection: %w", err)
+	}
+	rulesCollections = append(rulesCollections, natRules...)
+
+	return rulesCollections, nil
+}
+
 func resourceFirewallPolicyRuleCollectionGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
@@ -509,8 +324,22 @@ func resourceFirewallPolicyRuleCollectionGroupRead(d *pluginsdk.ResourceData, me
 
 	d.Set("name", resp.Name)
 	d.Set("priority", resp.Priority)
+	// the Rule Collections are only tracked when they're defined inline (or when importing) - otherwise these are
+	// managed by the standalone Rule Collection resources
+	importing := d.Get("firewall_policy_id").(string) == ""
+	managesRuleCollections := importing
+	for _, key := range []string{"application_rule_collection", "network_rule_collection", "nat_rule_collection"} {
+		if d.Get(key).(*pluginsdk.Set).Len() > 0 {
+			managesRuleCollections = true
+		}
+	}
+
 	d.Set("firewall_policy_id", parse.NewFirewallPolicyID(subscriptionId, id.ResourceGroup, id.FirewallPolicyName).ID())
 
+	if !managesRuleCollections {
+		return nil
+	}
+
 	applicationRuleCollections, networkRuleCollections, natRuleCollections, err := flattenFirewallPolicyRuleCollection(resp.RuleCollections)
 	if err != nil {
 		return fmt.Errorf("flattening Firewall Policy Rule Collections: %+v", err)
@@ -555,6 +384,282 @@ func resourceFirewallPolicyRuleCollectionGroupDelete(d *pluginsdk.ResourceData,
 	return nil
 }
 
+func firewallPolicyApplicationRuleSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeSet,
+		Required: true,
+		MinItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"description": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"protocols": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"type": {
+								Type:     pluginsdk.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(network.FirewallPolicyRuleApplicationProtocolTypeHTTP),
+									string(network.FirewallPolicyRuleApplicationProtocolTypeHTTPS),
+								}, false),
+							},
+							"port": {
+								Type:         pluginsdk.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(0, 64000),
+							},
+						},
+					},
+				},
+				"source_addresses": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.Any(
+							validation.IsIPAddress,
+							validation.IsCIDR,
+							validation.StringInSlice([]string{`*`}, false),
+						),
+					},
+				},
+				"source_ip_groups": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_addresses": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.Any(
+							validation.IsIPAddress,
+							validation.IsCIDR,
+							validation.StringInSlice([]string{`*`}, false),
+						),
+					},
+				},
+				"destination_fqdns": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_urls": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_fqdn_tags": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"terminate_tls": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+				},
+				"web_categories": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func firewallPolicyNetworkRuleSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeSet,
+		Required: true,
+		MinItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"protocols": {
+					Type:     pluginsdk.TypeSet,
+					Required: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(network.FirewallPolicyRuleNetworkProtocolAny),
+							string(network.FirewallPolicyRuleNetworkProtocolTCP),
+							string(network.FirewallPolicyRuleNetworkProtocolUDP),
+							string(network.FirewallPolicyRuleNetworkProtocolICMP),
+						}, false),
+					},
+				},
+				"source_addresses": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.Any(
+							validation.IsIPAddress,
+							validation.IsCIDR,
+							validation.StringInSlice([]string{`*`}, false),
+						),
+					},
+				},
+				"source_ip_groups": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_addresses": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						// Can be IP address, CIDR, "*", or service tag
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_ip_groups": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_fqdns": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_ports": {
+					Type:     pluginsdk.TypeSet,
+					Required: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.Any(
+							azValidate.PortOrPortRangeWithin(1, 65535),
+							validation.StringInSlice([]string{`*`}, false),
+						),
+					},
+				},
+			},
+		},
+	}
+}
+
+func firewallPolicyNatRuleSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeSet,
+		Required: true,
+		MinItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"protocols": {
+					Type:     pluginsdk.TypeSet,
+					Required: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(network.FirewallPolicyRuleNetworkProtocolTCP),
+							string(network.FirewallPolicyRuleNetworkProtocolUDP),
+						}, false),
+					},
+				},
+				"source_addresses": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.Any(
+							validation.IsIPAddress,
+							validation.IsCIDR,
+							validation.StringInSlice([]string{`*`}, false),
+						),
+					},
+				},
+				"source_ip_groups": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"destination_address": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.Any(
+						validation.IsIPAddress,
+						validation.IsCIDR,
+					),
+				},
+				"destination_ports": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: azValidate.PortOrPortRangeWithin(1, 64000),
+					},
+				},
+				"translated_address": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsIPAddress,
+				},
+				"translated_port": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IsPortNumber,
+				},
+				"translated_fqdn": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
 func expandFirewallPolicyRuleCollectionApplication(input []interface{}) []network.BasicFirewallPolicyRuleCollection {
 	return expandFirewallPolicyFilterRuleCollection(input, expandFirewallPolicyRuleApplication)
 }
@@ -0,0 +1,154 @@
+package firewall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// fakeRuleCollectionGroupApi returns the Rule Collection Group with an ETag which is incremented for each update,
+// rejecting the first `conflicts` updates as if the group had been modified concurrently
+type fakeRuleCollectionGroupApi struct {
+	sync.Mutex
+
+	conflicts int
+	etag      int
+	priority  int32
+	ifMatches []string
+}
+
+func (f *fakeRuleCollectionGroupApi) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		f.writeGroup(w)
+
+	case http.MethodPut:
+		f.ifMatches = append(f.ifMatches, r.Header.Get("If-Match"))
+
+		if f.conflicts > 0 || r.Header.Get("If-Match") != fmt.Sprintf("%d", f.etag) {
+			f.conflicts--
+			f.etag++
+			w.WriteHeader(http.StatusPreconditionFailed)
+			w.Write([]byte(`{"error": {"code": "PreconditionFailed", "message": "The ETag didn't match"}}`))
+			return
+		}
+
+		var group network.FirewallPolicyRuleCollectionGroup
+		if err := json.NewDecoder(r.Body).Decode(&group); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.priority = *group.Priority
+		f.etag++
+		f.writeGroup(w)
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (f *fakeRuleCollectionGroupApi) writeGroup(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(fmt.Sprintf(`{"etag": "%d", "properties": {"priority": %d, "provisioningState": "Succeeded"}}`, f.etag, f.priority)))
+}
+
+func TestUpdateFirewallPolicyRuleCollectionGroupRetriesOnPreconditionFailed(t *testing.T) {
+	testData := []struct {
+		name              string
+		conflicts         int
+		expectedIfMatches []string
+	}{
+		{
+			name:              "no conflict",
+			conflicts:         0,
+			expectedIfMatches: []string{"0"},
+		},
+		{
+			name:              "modified concurrently",
+			conflicts:         2,
+			expectedIfMatches: []string{"0", "1", "2"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			api := &fakeRuleCollectionGroupApi{
+				conflicts: v.conflicts,
+				priority:  100,
+			}
+			server := httptest.NewServer(api)
+			defer server.Close()
+
+			client := network.NewFirewallPolicyRuleCollectionGroupsClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+			client.RetryAttempts = 1
+			client.PollingDelay = 0
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			id := parse.NewFirewallPolicyRuleCollectionGroupID("00000000-0000-0000-0000-000000000000", "group1", "policy1", "rulecollectiongroup1")
+			modifications := 0
+			err := updateFirewallPolicyRuleCollectionGroup(ctx, &client, id, func(props *network.FirewallPolicyRuleCollectionGroupProperties) error {
+				modifications++
+				props.Priority = utils.Int32(200)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+
+			if modifications != len(v.expectedIfMatches) {
+				t.Fatalf("expected the group to be modified %d times but was modified %d times", len(v.expectedIfMatches), modifications)
+			}
+			if len(api.ifMatches) != len(v.expectedIfMatches) {
+				t.Fatalf("expected %d updates but got %d", len(v.expectedIfMatches), len(api.ifMatches))
+			}
+			for i, expected := range v.expectedIfMatches {
+				if api.ifMatches[i] != expected {
+					t.Fatalf("expected update %d to be conditional on the ETag %q but got %q", i, expected, api.ifMatches[i])
+				}
+			}
+			if api.priority != 200 {
+				t.Fatalf("expected the priority to be updated to 200 but got %d", api.priority)
+			}
+		})
+	}
+}
+
+func TestUpdateFirewallPolicyRuleCollectionGroupDoesNotRetryModifyErrors(t *testing.T) {
+	api := &fakeRuleCollectionGroupApi{
+		priority: 100,
+	}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	client := network.NewFirewallPolicyRuleCollectionGroupsClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	id := parse.NewFirewallPolicyRuleCollectionGroupID("00000000-0000-0000-0000-000000000000", "group1", "policy1", "rulecollectiongroup1")
+	err := updateFirewallPolicyRuleCollectionGroup(ctx, &client, id, func(props *network.FirewallPolicyRuleCollectionGroupProperties) error {
+		return fmt.Errorf("conflicting Rule Collections")
+	})
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	if len(api.ifMatches) != 0 {
+		t.Fatalf("expected no updates but got %d", len(api.ifMatches))
+	}
+}
@@ -11,6 +11,8 @@ type Client struct {
 	ManagementClient *keyvaultmgmt.BaseClient
 	VaultsClient     *keyvault.VaultsClient
 	options          *common.ClientOptions
+
+	dataPlaneReachability *dataPlaneReachabilityCache
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -28,6 +30,8 @@ func NewClient(o *common.ClientOptions) *Client {
 		ManagementClient: &managementClient,
 		VaultsClient:     &vaultsClient,
 		options:          o,
+
+		dataPlaneReachability: newDataPlaneReachabilityCache(),
 	}
 }
 
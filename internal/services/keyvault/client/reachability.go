@@ -0,0 +1,149 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// dataPlaneProbeTimeout is the maximum amount of time we'll wait to establish a connection to the Data Plane
+const dataPlaneProbeTimeout = 10 * time.Second
+
+// dataPlaneReachabilityCache caches whether the Data Plane of each Key Vault can be reached, keyed by Data Plane URI
+type dataPlaneReachabilityCache struct {
+	lock    sync.Mutex
+	entries map[string]*dataPlaneReachabilityEntry
+
+	// probe is overridable for testing
+	probe func(ctx context.Context, dataPlaneUri string) error
+}
+
+type dataPlaneReachabilityEntry struct {
+	// lock is held whilst the Data Plane is probed, so that concurrent callers for the same Key Vault
+	// wait on a single probe without blocking callers for other Key Vaults
+	lock      sync.Mutex
+	probed    bool
+	reachable bool
+}
+
+func newDataPlaneReachabilityCache() *dataPlaneReachabilityCache {
+	return &dataPlaneReachabilityCache{
+		entries: map[string]*dataPlaneReachabilityEntry{},
+		probe:   probeDataPlane,
+	}
+}
+
+func (c *dataPlaneReachabilityCache) entry(dataPlaneUri string) *dataPlaneReachabilityEntry {
+	cacheKey := strings.TrimSuffix(strings.ToLower(dataPlaneUri), "/")
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	v, ok := c.entries[cacheKey]
+	if !ok {
+		v = &dataPlaneReachabilityEntry{}
+		c.entries[cacheKey] = v
+	}
+	return v
+}
+
+func (c *dataPlaneReachabilityCache) isReachable(ctx context.Context, dataPlaneUri string) bool {
+	entry := c.entry(dataPlaneUri)
+
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	if entry.probed {
+		return entry.reachable
+	}
+
+	if err := c.probe(ctx, dataPlaneUri); err != nil {
+		log.Printf("[DEBUG] Key Vault Data Plane at %q is unreachable: %+v", dataPlaneUri, err)
+
+		// the probe failing because the request was cancelled says nothing about the Key Vault, so don't cache it
+		if ctx.Err() != nil {
+			return false
+		}
+
+		entry.probed = true
+		entry.reachable = false
+		return false
+	}
+
+	entry.probed = true
+	entry.reachable = true
+	return true
+}
+
+// IsDataPlaneReachable determines whether a TLS connection can be established to the Data Plane
+// of the Key Vault at the specified URI - the result is cached per Key Vault URI for the lifetime
+// of the provider, since this is used during refresh to avoid repeatedly waiting on the timeout.
+func (c *Client) IsDataPlaneReachable(ctx context.Context, dataPlaneUri string) bool {
+	if c.dataPlaneReachability == nil {
+		return probeDataPlane(ctx, dataPlaneUri) == nil
+	}
+
+	return c.dataPlaneReachability.isReachable(ctx, dataPlaneUri)
+}
+
+func probeDataPlane(ctx context.Context, dataPlaneUri string) error {
+	uri, err := url.Parse(dataPlaneUri)
+	if err != nil {
+		return fmt.Errorf("parsing %q: %+v", dataPlaneUri, err)
+	}
+
+	host := uri.Hostname()
+	if host == "" {
+		return fmt.Errorf("expected a hostname in %q but didn't get one", dataPlaneUri)
+	}
+	port := uri.Port()
+	if port == "" {
+		port = "443"
+	}
+
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{
+			Timeout: dataPlaneProbeTimeout,
+		},
+		Config: &tls.Config{
+			ServerName: host,
+			MinVersion: tls.VersionTLS12,
+		},
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, port))
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// IsDataPlaneUnreachableError returns whether the specified error was returned because the Data Plane
+// couldn't be reached - either due to a network failure or the Key Vault's Network ACL's (e.g. when
+// `public_network_access_enabled` is disabled and the request doesn't come via a Private Endpoint).
+func IsDataPlaneUnreachableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	message := strings.ToLower(err.Error())
+	for _, v := range []string{
+		"forbiddenbyconnection",
+		"forbiddenbyfirewall",
+		"no such host",
+		"i/o timeout",
+		"connection refused",
+		"connection reset by peer",
+	} {
+		if strings.Contains(message, v) {
+			return true
+		}
+	}
+
+	return false
+}
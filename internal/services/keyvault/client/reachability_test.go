@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestIsDataPlaneUnreachableError(t *testing.T) {
+	testData := []struct {
+		Input    error
+		Expected bool
+	}{
+		{
+			Input:    nil,
+			Expected: false,
+		},
+		{
+			Input:    fmt.Errorf("making Read request on Azure KeyVault Secret example: keyvault.BaseClient#GetSecret: Failure responding to request: StatusCode=404"),
+			Expected: false,
+		},
+		{
+			Input:    fmt.Errorf("keyvault.BaseClient#GetSecret: Failure responding to request: StatusCode=403 -- Original Error: autorest/azure: Service returned an error. Status=403 Code=\"Forbidden\" Message=\"Public network access is disabled and request is not from a trusted service nor via an approved private link.\" InnerError={\"code\":\"ForbiddenByConnection\"}"),
+			Expected: true,
+		},
+		{
+			Input:    fmt.Errorf("keyvault.BaseClient#GetKey: Failure sending request: StatusCode=0 -- Original Error: dial tcp: lookup example.vault.azure.net: no such host"),
+			Expected: true,
+		},
+		{
+			Input:    fmt.Errorf("dial tcp 10.0.0.4:443: i/o timeout"),
+			Expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v", v.Input)
+
+		actual := IsDataPlaneUnreachableError(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestDataPlaneReachabilityCache(t *testing.T) {
+	probes := 0
+	cache := newDataPlaneReachabilityCache()
+	cache.probe = func(ctx context.Context, dataPlaneUri string) error {
+		probes++
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if dataPlaneUri == "https://unreachable.vault.azure.net/" {
+			return fmt.Errorf("dial tcp: lookup unreachable.vault.azure.net: no such host")
+		}
+		return nil
+	}
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if cache.isReachable(cancelledCtx, "https://example.vault.azure.net/") {
+		t.Fatalf("expected a cancelled probe to be unreachable")
+	}
+	if !cache.isReachable(context.Background(), "https://example.vault.azure.net/") {
+		t.Fatalf("expected the result of a cancelled probe not to be cached")
+	}
+	if !cache.isReachable(context.Background(), "https://EXAMPLE.vault.azure.net") {
+		t.Fatalf("expected the cached result to be reachable")
+	}
+	if probes != 2 {
+		t.Fatalf("expected 2 probes but got %d", probes)
+	}
+
+	if cache.isReachable(context.Background(), "https://unreachable.vault.azure.net/") {
+		t.Fatalf("expected the Key Vault to be unreachable")
+	}
+	if cache.isReachable(context.Background(), "https://unreachable.vault.azure.net/") {
+		t.Fatalf("expected the cached result to be unreachable")
+	}
+	if probes != 3 {
+		t.Fatalf("expected 3 probes but got %d", probes)
+	}
+}
@@ -9,7 +9,9 @@ import (
 	"time"
 
 	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	keyVaultClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -149,3 +151,84 @@ func nestedItemResourceImporter(ctx context.Context, d *pluginsdk.ResourceData,
 
 	return []*pluginsdk.ResourceData{d}, nil
 }
+
+// nestedItemReadWithReachabilityCheck wraps the Read function for a Key Vault Nested Item (Certificate, Key or Secret)
+// such that, when opted-in via the `features` block, a Data Plane which can't be reached (for example a Key Vault which
+// is only accessible via a Private Endpoint) results in a warning and the existing state being retained, rather than
+// an error which would otherwise block the entire plan.
+func nestedItemReadWithReachabilityCheck(read pluginsdk.ReadFunc) func(context.Context, *pluginsdk.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+		if !meta.(*clients.Client).Features.KeyVault.IgnoreUnreachableDataPlaneOnRead {
+			return diag.FromErr(read(d, meta))
+		}
+
+		id, err := parse.ParseNestedItemID(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// a Key Vault which has been deleted is also unreachable, so check it exists via the Management Plane first - in
+		// which case the wrapped Read removes the item from the state without needing to reach the Data Plane
+		exists, err := nestedItemKeyVaultExists(ctx, meta, id.KeyVaultBaseUrl)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !exists {
+			return diag.FromErr(read(d, meta))
+		}
+
+		if !meta.(*clients.Client).KeyVault.IsDataPlaneReachable(ctx, id.KeyVaultBaseUrl) {
+			return unreachableDataPlaneDiagnostics(id, nil)
+		}
+
+		if err := read(d, meta); err != nil {
+			if keyVaultClient.IsDataPlaneUnreachableError(err) {
+				return unreachableDataPlaneDiagnostics(id, err)
+			}
+			return diag.FromErr(err)
+		}
+
+		return nil
+	}
+}
+
+func nestedItemKeyVaultExists(ctx context.Context, meta interface{}, keyVaultBaseUrl string) (bool, error) {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	resourcesClient := meta.(*clients.Client).Resource
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, keyVaultBaseUrl)
+	if err != nil {
+		return false, fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", keyVaultBaseUrl, err)
+	}
+	if keyVaultIdRaw == nil {
+		return false, nil
+	}
+
+	keyVaultId, err := parse.VaultID(*keyVaultIdRaw)
+	if err != nil {
+		return false, err
+	}
+
+	exists, err := keyVaultsClient.Exists(ctx, *keyVaultId)
+	if err != nil {
+		return false, fmt.Errorf("checking if %s exists: %v", *keyVaultId, err)
+	}
+
+	return exists, nil
+}
+
+func unreachableDataPlaneDiagnostics(id *parse.NestedItemId, err error) diag.Diagnostics {
+	detail := fmt.Sprintf("The Key Vault at %q couldn't be reached when refreshing %q - the existing state has been retained. This can happen when the Key Vault is only accessible via a Private Endpoint which isn't reachable from where Terraform is running.", id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		detail = fmt.Sprintf("%s\n\nError: %+v", detail, err)
+	}
+	log.Printf("[WARN] %s", detail)
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Key Vault Data Plane at %q is unreachable", id.KeyVaultBaseUrl),
+			Detail:   detail,
+		},
+	}
+}
@@ -29,10 +29,10 @@ import (
 func resourceKeyVaultCertificate() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		// TODO: support Updating once we have more information about what can be updated
-		Create: resourceKeyVaultCertificateCreate,
-		Read:   resourceKeyVaultCertificateRead,
-		Delete: resourceKeyVaultCertificateDelete,
-		Update: resourceKeyVaultCertificateUpdate,
+		Create:      resourceKeyVaultCertificateCreate,
+		ReadContext: nestedItemReadWithReachabilityCheck(resourceKeyVaultCertificateRead),
+		Delete:      resourceKeyVaultCertificateDelete,
+		Update:      resourceKeyVaultCertificateUpdate,
 
 		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			_, err := parse.ParseNestedItemID(id)
@@ -432,11 +432,18 @@ func resourceKeyVaultCertificateCreate(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("looking up Base URI for Certificate %q in %s: %+v", name, *keyVaultId, err)
 	}
 
-	existing, err := client.GetCertificate(ctx, *keyVaultBaseUrl, name, "")
-	if err != nil {
-		if !utils.ResponseWasNotFound(existing.Response) {
-			return fmt.Errorf("checking for presence of existing Certificate %q in %s: %s", name, *keyVaultBaseUrl, err)
+	// when using RBAC Authorization any newly assigned Roles can take a while to propagate
+	var existing keyvault.CertificateBundle
+	err = retryDataPlaneRequestDuringRbacPropagation(ctx, meta, *keyVaultId, func() (autorest.Response, error) {
+		resp, err := client.GetCertificate(ctx, *keyVaultBaseUrl, name, "")
+		existing = resp
+		if err != nil && utils.ResponseWasNotFound(resp.Response) {
+			return resp.Response, nil
 		}
+		return resp.Response, err
+	})
+	if err != nil {
+		return fmt.Errorf("checking for presence of existing Certificate %q in %s: %s", name, *keyVaultBaseUrl, err)
 	}
 
 	if existing.ID != nil && *existing.ID != "" {
@@ -30,11 +30,11 @@ import (
 
 func resourceKeyVaultKey() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create:   resourceKeyVaultKeyCreate,
-		Read:     resourceKeyVaultKeyRead,
-		Update:   resourceKeyVaultKeyUpdate,
-		Delete:   resourceKeyVaultKeyDelete,
-		Importer: pluginsdk.DefaultImporter(),
+		Create:      resourceKeyVaultKeyCreate,
+		ReadContext: nestedItemReadWithReachabilityCheck(resourceKeyVaultKeyRead),
+		Update:      resourceKeyVaultKeyUpdate,
+		Delete:      resourceKeyVaultKeyDelete,
+		Importer:    pluginsdk.DefaultImporter(),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -196,11 +196,18 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("looking up Key %q vault url from id %q: %+v", name, *keyVaultId, err)
 	}
 
-	existing, err := client.GetKey(ctx, *keyVaultBaseUri, name, "")
-	if err != nil {
-		if !utils.ResponseWasNotFound(existing.Response) {
-			return fmt.Errorf("checking for presence of existing Key %q (Key Vault %q): %s", name, *keyVaultBaseUri, err)
+	// when using RBAC Authorization any newly assigned Roles can take a while to propagate
+	var existing keyvault.KeyBundle
+	err = retryDataPlaneRequestDuringRbacPropagation(ctx, meta, *keyVaultId, func() (autorest.Response, error) {
+		resp, err := client.GetKey(ctx, *keyVaultBaseUri, name, "")
+		existing = resp
+		if err != nil && utils.ResponseWasNotFound(resp.Response) {
+			return resp.Response, nil
 		}
+		return resp.Response, err
+	})
+	if err != nil {
+		return fmt.Errorf("checking for presence of existing Key %q (Key Vault %q): %s", name, *keyVaultBaseUri, err)
 	}
 
 	if existing.Key != nil && existing.Key.Kid != nil && *existing.Key.Kid != "" {
@@ -10,6 +10,7 @@ import (
 
 	KeyVaultMgmt "github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/Azure/azure-sdk-for-go/services/preview/keyvault/mgmt/2020-04-01-preview/keyvault"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/gofrs/uuid"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -141,6 +142,8 @@ func resourceKeyVault() *pluginsdk.Resource {
 					Optional: true,
 				},
 
+				"current_principal_role_assignment": schemaKeyVaultCurrentPrincipalRoleAssignment(),
+
 				"network_acls": {
 					Type:     pluginsdk.TypeList,
 					Optional: true,
@@ -297,6 +300,11 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	enableRbacAuthorization := d.Get("enable_rbac_authorization").(bool)
 	t := d.Get("tags").(map[string]interface{})
 
+	currentPrincipalRoleDefinitionName := expandCurrentPrincipalRoleAssignmentRoleName(d.Get("current_principal_role_assignment").([]interface{}))
+	if currentPrincipalRoleDefinitionName != nil && !enableRbacAuthorization {
+		return fmt.Errorf("`current_principal_role_assignment` can only be specified when `enable_rbac_authorization` is set to `true`")
+	}
+
 	policies := d.Get("access_policy").([]interface{})
 	accessPolicies := expandAccessPolicies(policies)
 
@@ -388,6 +396,18 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if currentPrincipalRoleDefinitionName != nil {
+		roleAssignmentId, err := createCurrentPrincipalRoleAssignment(ctx, meta, id, *currentPrincipalRoleDefinitionName)
+		if err != nil {
+			return err
+		}
+
+		principalId := meta.(*clients.Client).Account.ObjectId
+		if err := d.Set("current_principal_role_assignment", flattenCurrentPrincipalRoleAssignment(*currentPrincipalRoleDefinitionName, principalId, *roleAssignmentId)); err != nil {
+			return fmt.Errorf("setting `current_principal_role_assignment`: %+v", err)
+		}
+	}
+
 	if v, ok := d.GetOk("contact"); ok {
 		contacts := KeyVaultMgmt.Contacts{
 			ContactList: expandKeyVaultCertificateContactList(v.(*pluginsdk.Set).List()),
@@ -395,7 +415,11 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		if read.Properties == nil || read.Properties.VaultURI == nil {
 			return fmt.Errorf("failed to get vault base url for %s: %s", id, err)
 		}
-		if _, err := dataPlaneClient.SetCertificateContacts(ctx, *read.Properties.VaultURI, contacts); err != nil {
+		err := retryDataPlaneRequestDuringRbacPropagation(ctx, meta, id, func() (autorest.Response, error) {
+			resp, err := dataPlaneClient.SetCertificateContacts(ctx, *read.Properties.VaultURI, contacts)
+			return resp.Response, err
+		})
+		if err != nil {
 			return fmt.Errorf("failed to set Contacts for %s: %+v", id, err)
 		}
 	}
@@ -569,10 +593,40 @@ func resourceKeyVaultUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		update.Tags = tags.Expand(t)
 	}
 
+	currentPrincipalRoleDefinitionName := expandCurrentPrincipalRoleAssignmentRoleName(d.Get("current_principal_role_assignment").([]interface{}))
+	if currentPrincipalRoleDefinitionName != nil && !d.Get("enable_rbac_authorization").(bool) {
+		return fmt.Errorf("`current_principal_role_assignment` can only be specified when `enable_rbac_authorization` is set to `true`")
+	}
+
 	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, update); err != nil {
 		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
 
+	if d.HasChange("current_principal_role_assignment") {
+		old, _ := d.GetChange("current_principal_role_assignment")
+		if oldRaw := old.([]interface{}); len(oldRaw) > 0 && oldRaw[0] != nil {
+			if roleAssignmentId := oldRaw[0].(map[string]interface{})["role_assignment_id"].(string); roleAssignmentId != "" {
+				if err := deleteCurrentPrincipalRoleAssignment(ctx, meta, roleAssignmentId); err != nil {
+					return fmt.Errorf("removing the existing `current_principal_role_assignment` for %s: %+v", *id, err)
+				}
+			}
+		}
+
+		roleAssignment := make([]interface{}, 0)
+		if currentPrincipalRoleDefinitionName != nil {
+			roleAssignmentId, err := createCurrentPrincipalRoleAssignment(ctx, meta, *id, *currentPrincipalRoleDefinitionName)
+			if err != nil {
+				return err
+			}
+
+			principalId := meta.(*clients.Client).Account.ObjectId
+			roleAssignment = flattenCurrentPrincipalRoleAssignment(*currentPrincipalRoleDefinitionName, principalId, *roleAssignmentId)
+		}
+		if err := d.Set("current_principal_role_assignment", roleAssignment); err != nil {
+			return fmt.Errorf("setting `current_principal_role_assignment`: %+v", err)
+		}
+	}
+
 	if d.HasChange("contact") {
 		contacts := KeyVaultMgmt.Contacts{
 			ContactList: expandKeyVaultCertificateContactList(d.Get("contact").(*pluginsdk.Set).List()),
@@ -581,13 +635,15 @@ func resourceKeyVaultUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 			return fmt.Errorf("failed to get vault base url for %s: %s", *id, err)
 		}
 
-		var err error
-		if len(*contacts.ContactList) == 0 {
-			_, err = managementClient.DeleteCertificateContacts(ctx, *existing.Properties.VaultURI)
-		} else {
-			_, err = managementClient.SetCertificateContacts(ctx, *existing.Properties.VaultURI, contacts)
-		}
+		err := retryDataPlaneRequestDuringRbacPropagation(ctx, meta, *id, func() (autorest.Response, error) {
+			if len(*contacts.ContactList) == 0 {
+				resp, err := managementClient.DeleteCertificateContacts(ctx, *existing.Properties.VaultURI)
+				return resp.Response, err
+			}
 
+			resp, err := managementClient.SetCertificateContacts(ctx, *existing.Properties.VaultURI, contacts)
+			return resp.Response, err
+		})
 		if err != nil {
 			return fmt.Errorf("setting Contacts for %s: %+v", *id, err)
 		}
@@ -601,6 +657,7 @@ func resourceKeyVaultUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 func resourceKeyVaultRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).KeyVault.VaultsClient
 	managementClient := meta.(*clients.Client).KeyVault.ManagementClient
+	roleAssignmentsClient := meta.(*clients.Client).Authorization.RoleAssignmentsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -675,6 +732,21 @@ func resourceKeyVaultRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `access_policy` for KeyVault %q: %+v", *resp.Name, err)
 	}
 
+	// the Role Assignment is only tracked when it was created by Terraform, so check it still exists
+	if v := d.Get("current_principal_role_assignment").([]interface{}); len(v) > 0 && v[0] != nil {
+		if roleAssignmentId := v[0].(map[string]interface{})["role_assignment_id"].(string); roleAssignmentId != "" {
+			roleAssignment, err := roleAssignmentsClient.GetByID(ctx, roleAssignmentId, "")
+			if err != nil {
+				if !utils.ResponseWasNotFound(roleAssignment.Response) {
+					return fmt.Errorf("retrieving Role Assignment %q for %s: %+v", roleAssignmentId, *id, err)
+				}
+
+				log.Printf("[DEBUG] Role Assignment %q for %s was not found - removing `current_principal_role_assignment` from state", roleAssignmentId, *id)
+				d.Set("current_principal_role_assignment", []interface{}{})
+			}
+		}
+	}
+
 	contactsResp, err := managementClient.GetCertificateContacts(ctx, *props.VaultURI)
 	if err != nil {
 		if !utils.ResponseWasForbidden(contactsResp.Response) && !utils.ResponseWasNotFound(contactsResp.Response) {
@@ -717,6 +789,14 @@ func resourceKeyVaultDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("retrieving %q: `location` was nil", *id)
 	}
 
+	if v := d.Get("current_principal_role_assignment").([]interface{}); len(v) > 0 && v[0] != nil {
+		if roleAssignmentId := v[0].(map[string]interface{})["role_assignment_id"].(string); roleAssignmentId != "" {
+			if err := deleteCurrentPrincipalRoleAssignment(ctx, meta, roleAssignmentId); err != nil {
+				return fmt.Errorf("removing the `current_principal_role_assignment` for %s: %+v", *id, err)
+			}
+		}
+	}
+
 	// Check to see if purge protection is enabled or not...
 	purgeProtectionEnabled := false
 	if ppe := read.Properties.EnablePurgeProtection; ppe != nil {
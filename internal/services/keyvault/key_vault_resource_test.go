@@ -51,6 +51,31 @@ func TestAccKeyVault_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccKeyVault_currentPrincipalRoleAssignment(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault", "test")
+	r := KeyVaultResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.currentPrincipalRoleAssignment(data, "Key Vault Administrator"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("current_principal_role_assignment.0.role_assignment_id").Exists(),
+				check.That("azurerm_key_vault_secret.test").ExistsInAzure(KeyVaultSecretResource{}),
+			),
+		},
+		data.ImportStep("current_principal_role_assignment"),
+		{
+			Config: r.currentPrincipalRoleAssignment(data, "Key Vault Secrets Officer"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("current_principal_role_assignment.0.role_definition_name").HasValue("Key Vault Secrets Officer"),
+			),
+		},
+		data.ImportStep("current_principal_role_assignment"),
+	})
+}
+
 func TestAccKeyVault_networkAcls(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_key_vault", "test")
 	r := KeyVaultResource{}
@@ -701,6 +726,42 @@ resource "azurerm_key_vault" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (KeyVaultResource) currentPrincipalRoleAssignment(data acceptance.TestData, roleDefinitionName string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                       = "vault%d"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  tenant_id                  = data.azurerm_client_config.current.tenant_id
+  sku_name                   = "standard"
+  soft_delete_retention_days = 7
+  enable_rbac_authorization  = true
+
+  current_principal_role_assignment {
+    role_definition_name = "%s"
+  }
+}
+
+resource "azurerm_key_vault_secret" "test" {
+  name         = "secret-%s"
+  value        = "rick-and-morty"
+  key_vault_id = azurerm_key_vault.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, roleDefinitionName, data.RandomString)
+}
+
 func (KeyVaultResource) noAccessPolicyBlocks(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,226 @@
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// rbacPropagationTimeout is the maximum amount of time we'll retry a Data Plane request which returns a 403
+// for a Key Vault using RBAC Authorization, whilst any newly created Role Assignments replicate in AAD
+const rbacPropagationTimeout = 10 * time.Minute
+
+const defaultCurrentPrincipalRoleDefinitionName = "Key Vault Administrator"
+
+func schemaKeyVaultCurrentPrincipalRoleAssignment() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"role_definition_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      defaultCurrentPrincipalRoleDefinitionName,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"principal_id": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"role_assignment_id": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// createCurrentPrincipalRoleAssignment assigns the specified Role to the Principal Terraform is running as, scoped
+// to the Key Vault - and then waits for the Role Assignment to become available.
+func createCurrentPrincipalRoleAssignment(ctx context.Context, meta interface{}, id parse.VaultId, roleDefinitionName string) (*string, error) {
+	roleAssignmentsClient := meta.(*clients.Client).Authorization.RoleAssignmentsClient
+	roleDefinitionsClient := meta.(*clients.Client).Authorization.RoleDefinitionsClient
+	principalId := meta.(*clients.Client).Account.ObjectId
+
+	if principalId == "" {
+		return nil, fmt.Errorf("unable to determine the Object ID of the Principal Terraform is running as")
+	}
+
+	scope := id.ID()
+	roleDefinitions, err := roleDefinitionsClient.List(ctx, scope, fmt.Sprintf("roleName eq '%s'", roleDefinitionName))
+	if err != nil {
+		return nil, fmt.Errorf("loading Role Definition List: %+v", err)
+	}
+	if len(roleDefinitions.Values()) != 1 || roleDefinitions.Values()[0].ID == nil {
+		return nil, fmt.Errorf("loading Role Definition List: could not find role %q", roleDefinitionName)
+	}
+	roleDefinitionId := *roleDefinitions.Values()[0].ID
+
+	name, err := uuid.GenerateUUID()
+	if err != nil {
+		return nil, fmt.Errorf("generating UUID for Role Assignment: %+v", err)
+	}
+
+	properties := authorization.RoleAssignmentCreateParameters{
+		RoleAssignmentProperties: &authorization.RoleAssignmentProperties{
+			RoleDefinitionID: utils.String(roleDefinitionId),
+			PrincipalID:      utils.String(principalId),
+		},
+	}
+
+	timeout, ok := ctx.Deadline()
+	if !ok {
+		return nil, fmt.Errorf("context is missing a timeout")
+	}
+
+	log.Printf("[DEBUG] Assigning Role %q to Principal %q for %s..", roleDefinitionName, principalId, id)
+	var roleAssignmentId string
+	err = pluginsdk.Retry(time.Until(timeout), func() *pluginsdk.RetryError {
+		resp, err := roleAssignmentsClient.Create(ctx, scope, name, properties)
+		if err != nil {
+			if utils.ResponseErrorIsRetryable(err) {
+				return pluginsdk.RetryableError(err)
+			} else if utils.ResponseWasStatusCode(resp.Response, 400) && strings.Contains(err.Error(), "PrincipalNotFound") {
+				return pluginsdk.RetryableError(err)
+			}
+
+			return pluginsdk.NonRetryableError(err)
+		}
+		if resp.ID == nil {
+			return pluginsdk.NonRetryableError(fmt.Errorf("creation of Role Assignment %q did not return an id value", name))
+		}
+
+		roleAssignmentId = *resp.ID
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("assigning Role %q to Principal %q for %s: %+v", roleDefinitionName, principalId, id, err)
+	}
+
+	log.Printf("[DEBUG] Waiting for Role Assignment %q for %s to finish replicating..", roleAssignmentId, id)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"ready"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := roleAssignmentsClient.GetByID(ctx, roleAssignmentId, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return resp, "pending", nil
+				}
+				return resp, "failed", err
+			}
+			return resp, "ready", nil
+		},
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 5,
+		Timeout:                   time.Until(timeout),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return nil, fmt.Errorf("waiting for Role Assignment %q for %s to finish replicating: %+v", roleAssignmentId, id, err)
+	}
+
+	return &roleAssignmentId, nil
+}
+
+func deleteCurrentPrincipalRoleAssignment(ctx context.Context, meta interface{}, roleAssignmentId string) error {
+	client := meta.(*clients.Client).Authorization.RoleAssignmentsClient
+
+	log.Printf("[DEBUG] Deleting Role Assignment %q..", roleAssignmentId)
+	resp, err := client.DeleteByID(ctx, roleAssignmentId, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("deleting Role Assignment %q: %+v", roleAssignmentId, err)
+		}
+	}
+
+	return nil
+}
+
+func expandCurrentPrincipalRoleAssignmentRoleName(input []interface{}) *string {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return utils.String(raw["role_definition_name"].(string))
+}
+
+func flattenCurrentPrincipalRoleAssignment(roleDefinitionName, principalId, roleAssignmentId string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"role_definition_name": roleDefinitionName,
+			"principal_id":         principalId,
+			"role_assignment_id":   roleAssignmentId,
+		},
+	}
+}
+
+// retryDataPlaneRequestDuringRbacPropagation retries a Data Plane request which returns a 403 for a bounded
+// window when the Key Vault uses RBAC Authorization, since newly created Role Assignments can take several
+// minutes to replicate - Key Vaults using Access Policies return the error immediately.
+func retryDataPlaneRequestDuringRbacPropagation(ctx context.Context, meta interface{}, keyVaultId parse.VaultId, request func() (autorest.Response, error)) error {
+	resp, err := request()
+	if err == nil || !utils.ResponseWasForbidden(resp) {
+		return err
+	}
+
+	usesRbac, rbacErr := keyVaultUsesRbacAuthorization(ctx, meta, keyVaultId)
+	if rbacErr != nil {
+		log.Printf("[DEBUG] Unable to determine whether %s uses RBAC Authorization: %+v", keyVaultId, rbacErr)
+		return err
+	}
+	if !usesRbac {
+		return err
+	}
+
+	timeout := rbacPropagationTimeout
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < timeout {
+		timeout = time.Until(deadline)
+	}
+
+	log.Printf("[DEBUG] %s uses RBAC Authorization and returned a 403 - retrying for up to %s whilst Role Assignments propagate..", keyVaultId, timeout)
+	return pluginsdk.Retry(timeout, func() *pluginsdk.RetryError {
+		resp, err := request()
+		if err != nil {
+			if utils.ResponseWasForbidden(resp) {
+				return pluginsdk.RetryableError(err)
+			}
+			return pluginsdk.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+func keyVaultUsesRbacAuthorization(ctx context.Context, meta interface{}, keyVaultId parse.VaultId) (bool, error) {
+	client := meta.(*clients.Client).KeyVault.VaultsClient
+	if keyVaultId.SubscriptionId != client.SubscriptionID {
+		client = meta.(*clients.Client).KeyVault.KeyVaultClientForSubscription(keyVaultId.SubscriptionId)
+	}
+
+	resp, err := client.Get(ctx, keyVaultId.ResourceGroup, keyVaultId.Name)
+	if err != nil {
+		return false, fmt.Errorf("retrieving %s: %+v", keyVaultId, err)
+	}
+
+	if props := resp.Properties; props != nil && props.EnableRbacAuthorization != nil {
+		return *props.EnableRbacAuthorization, nil
+	}
+
+	return false, nil
+}
@@ -22,10 +22,10 @@ import (
 
 func resourceKeyVaultSecret() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceKeyVaultSecretCreate,
-		Read:   resourceKeyVaultSecretRead,
-		Update: resourceKeyVaultSecretUpdate,
-		Delete: resourceKeyVaultSecretDelete,
+		Create:      resourceKeyVaultSecretCreate,
+		ReadContext: nestedItemReadWithReachabilityCheck(resourceKeyVaultSecretRead),
+		Update:      resourceKeyVaultSecretUpdate,
+		Delete:      resourceKeyVaultSecretDelete,
 		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			_, err := parse.ParseNestedItemID(id)
 			return err
@@ -110,11 +110,18 @@ func resourceKeyVaultSecretCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("looking up Secret %q vault url from id %q: %+v", name, *keyVaultId, err)
 	}
 
-	existing, err := client.GetSecret(ctx, *keyVaultBaseUrl, name, "")
-	if err != nil {
-		if !utils.ResponseWasNotFound(existing.Response) {
-			return fmt.Errorf("checking for presence of existing Secret %q (Key Vault %q): %s", name, *keyVaultBaseUrl, err)
+	// when using RBAC Authorization any newly assigned Roles can take a while to propagate
+	var existing keyvault.SecretBundle
+	err = retryDataPlaneRequestDuringRbacPropagation(ctx, meta, *keyVaultId, func() (autorest.Response, error) {
+		resp, err := client.GetSecret(ctx, *keyVaultBaseUrl, name, "")
+		existing = resp
+		if err != nil && utils.ResponseWasNotFound(resp.Response) {
+			return resp.Response, nil
 		}
+		return resp.Response, err
+	})
+	if err != nil {
+		return fmt.Errorf("checking for presence of existing Secret %q (Key Vault %q): %s", name, *keyVaultBaseUrl, err)
 	}
 
 	if existing.ID != nil && *existing.ID != "" {
@@ -27,6 +27,7 @@ type Client struct {
 	DiagnosticSettingsCategoryClient *classic.DiagnosticSettingsCategoryClient
 	LogProfilesClient                *classic.LogProfilesClient
 	MetricAlertsClient               *classic.MetricAlertsClient
+	MetricsClient                    *classic.MetricsClient
 	PrivateLinkScopesClient          *classic.PrivateLinkScopesClient
 	PrivateLinkScopedResourcesClient *classic.PrivateLinkScopedResourcesClient
 	ScheduledQueryRulesClient        *classic.ScheduledQueryRulesClient
@@ -66,6 +67,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	MetricAlertsClient := classic.NewMetricAlertsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&MetricAlertsClient.Client, o.ResourceManagerAuthorizer)
 
+	MetricsClient := classic.NewMetricsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&MetricsClient.Client, o.ResourceManagerAuthorizer)
+
 	PrivateLinkScopesClient := classic.NewPrivateLinkScopesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&PrivateLinkScopesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -87,6 +91,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		DiagnosticSettingsCategoryClient: &DiagnosticSettingsCategoryClient,
 		LogProfilesClient:                &LogProfilesClient,
 		MetricAlertsClient:               &MetricAlertsClient,
+		MetricsClient:                    &MetricsClient,
 		PrivateLinkScopesClient:          &PrivateLinkScopesClient,
 		PrivateLinkScopedResourcesClient: &PrivateLinkScopedResourcesClient,
 		ScheduledQueryRulesClient:        &ScheduledQueryRulesClient,
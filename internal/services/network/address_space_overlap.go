@@ -0,0 +1,326 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	commonValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// this is only here to aid testing
+var addressSpaceOverlapEnhancedValidationEnabled = features.EnhancedValidationEnabled()
+
+type addressPrefixOverlap struct {
+	first  string
+	second string
+}
+
+// findAddressPrefixOverlap returns the first address prefix from `first` which overlaps an address prefix from `second`
+func findAddressPrefixOverlap(first []string, second []string) (*addressPrefixOverlap, error) {
+	for _, a := range first {
+		for _, b := range second {
+			// unknown values are returned as empty strings during plan
+			if a == "" || b == "" {
+				continue
+			}
+
+			overlaps, err := commonValidate.CIDRsOverlap(a, b)
+			if err != nil {
+				return nil, err
+			}
+			if overlaps {
+				return &addressPrefixOverlap{
+					first:  a,
+					second: b,
+				}, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// findAddressPrefixOverlapWithin returns the first pair of address prefixes within `prefixes` which overlap one another
+func findAddressPrefixOverlapWithin(prefixes []string) (*addressPrefixOverlap, error) {
+	for i := range prefixes {
+		overlap, err := findAddressPrefixOverlap(prefixes[i:i+1], prefixes[i+1:])
+		if err != nil || overlap != nil {
+			return overlap, err
+		}
+	}
+
+	return nil, nil
+}
+
+// virtualNetworkAddressSpaceOverlapCustomizeDiff checks that neither the `address_space` nor the inline Subnets of the
+// Virtual Network overlap - and when Enhanced Validation is enabled, that the `address_space` doesn't overlap the
+// address space of any Virtual Network which is currently peered with this one
+func virtualNetworkAddressSpaceOverlapCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("address_space") {
+		return nil
+	}
+
+	addressSpace := *utils.ExpandStringSlice(d.Get("address_space").([]interface{}))
+	overlap, err := findAddressPrefixOverlapWithin(addressSpace)
+	if err != nil {
+		return fmt.Errorf("validating `address_space`: %+v", err)
+	}
+	if overlap != nil {
+		return fmt.Errorf("the address spaces %q and %q within `address_space` overlap", overlap.first, overlap.second)
+	}
+
+	if d.NewValueKnown("subnet") {
+		subnetNames := make(map[string]string)
+		subnetPrefixes := make([]string, 0)
+		for _, raw := range d.Get("subnet").(*pluginsdk.Set).List() {
+			subnet := raw.(map[string]interface{})
+			prefix := subnet["address_prefix"].(string)
+			subnetNames[prefix] = subnet["name"].(string)
+			subnetPrefixes = append(subnetPrefixes, prefix)
+		}
+
+		overlap, err := findAddressPrefixOverlapWithin(subnetPrefixes)
+		if err != nil {
+			return fmt.Errorf("validating `subnet`: %+v", err)
+		}
+		if overlap != nil {
+			return fmt.Errorf("the address prefix %q of the Subnet %q overlaps the address prefix %q of the Subnet %q", overlap.first, subnetNames[overlap.first], overlap.second, subnetNames[overlap.second])
+		}
+	}
+
+	if d.Id() == "" || !d.HasChange("address_space") || !addressSpaceOverlapEnhancedValidationEnabled {
+		return nil
+	}
+
+	id, err := parse.VirtualNetworkID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*clients.Client).Network.VnetClient
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		// this is best-effort, the API will reject any overlapping address space during the apply
+		log.Printf("[DEBUG] unable to retrieve %s to check the address space of peered Virtual Networks: %+v", *id, err)
+		return nil
+	}
+	if existing.VirtualNetworkPropertiesFormat == nil || existing.VirtualNetworkPropertiesFormat.VirtualNetworkPeerings == nil {
+		return nil
+	}
+
+	for _, peering := range *existing.VirtualNetworkPropertiesFormat.VirtualNetworkPeerings {
+		remoteAddressSpace := virtualNetworkPeeringRemoteAddressSpace(peering.VirtualNetworkPeeringPropertiesFormat)
+		overlap, err := findAddressPrefixOverlap(addressSpace, remoteAddressSpace)
+		if err != nil {
+			return fmt.Errorf("validating `address_space`: %+v", err)
+		}
+		if overlap != nil {
+			return fmt.Errorf("the address space %q overlaps the address space %q of the Virtual Network peered via the Virtual Network Peering %q", overlap.first, overlap.second, utils.NormalizeNilableString(peering.Name))
+		}
+	}
+
+	return nil
+}
+
+// subnetAddressPrefixOverlapCustomizeDiff checks that the address prefixes of the Subnet don't overlap one another - and
+// when Enhanced Validation is enabled, that they don't overlap the address prefixes of the other Subnets within the
+// Virtual Network
+func subnetAddressPrefixOverlapCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	addressPrefixes := make([]string, 0)
+	if v := d.GetRawConfig().GetAttr("address_prefixes"); !v.IsNull() {
+		if !d.NewValueKnown("address_prefixes") {
+			return nil
+		}
+
+		addressPrefixes = *utils.ExpandStringSlice(d.Get("address_prefixes").([]interface{}))
+		overlap, err := findAddressPrefixOverlapWithin(addressPrefixes)
+		if err != nil {
+			return fmt.Errorf("validating `address_prefixes`: %+v", err)
+		}
+		if overlap != nil {
+			return fmt.Errorf("the address prefixes %q and %q within `address_prefixes` overlap", overlap.first, overlap.second)
+		}
+	} else if v := d.GetRawConfig().GetAttr("address_prefix"); !v.IsNull() {
+		if !d.NewValueKnown("address_prefix") {
+			return nil
+		}
+
+		addressPrefixes = append(addressPrefixes, d.Get("address_prefix").(string))
+	}
+
+	// an allocated range (`address_prefix_length`) is chosen from the free space within the Virtual Network, so can't
+	// overlap another Subnet
+	if len(addressPrefixes) == 0 || !addressSpaceOverlapEnhancedValidationEnabled {
+		return nil
+	}
+
+	// only look up the sibling Subnets when the address prefixes are being set or changed
+	if d.Id() != "" && !d.HasChange("address_prefix") && !d.HasChange("address_prefixes") {
+		return nil
+	}
+
+	for _, key := range []string{"name", "resource_group_name", "virtual_network_name"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	client := meta.(*clients.Client).Network.VnetClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	vnetId := parse.NewVirtualNetworkID(subscriptionId, d.Get("resource_group_name").(string), d.Get("virtual_network_name").(string))
+	vnet, err := client.Get(ctx, vnetId.ResourceGroup, vnetId.Name, "")
+	if err != nil {
+		// this is best-effort, the Virtual Network may not exist yet and the API will reject any overlap during the apply
+		log.Printf("[DEBUG] unable to retrieve %s to check the address prefixes of the other Subnets: %+v", vnetId, err)
+		return nil
+	}
+
+	name := d.Get("name").(string)
+	siblingName, overlap, err := findSubnetAddressPrefixOverlapWithSiblings(name, addressPrefixes, vnet)
+	if err != nil {
+		return fmt.Errorf("validating the address prefixes of the Subnet %q: %+v", name, err)
+	}
+	if overlap != nil {
+		return fmt.Errorf("the address prefix %q overlaps the address prefix %q of the Subnet %q within %s", overlap.first, overlap.second, siblingName, vnetId)
+	}
+
+	return nil
+}
+
+// findSubnetAddressPrefixOverlapWithSiblings returns the first address prefix from `addressPrefixes` which overlaps the
+// address prefixes of another Subnet within the Virtual Network, along with the name of that Subnet - the Subnet named
+// `name` is excluded since this is the Subnet being validated
+func findSubnetAddressPrefixOverlapWithSiblings(name string, addressPrefixes []string, vnet network.VirtualNetwork) (string, *addressPrefixOverlap, error) {
+	if vnet.VirtualNetworkPropertiesFormat == nil || vnet.VirtualNetworkPropertiesFormat.Subnets == nil {
+		return "", nil, nil
+	}
+
+	for _, subnet := range *vnet.VirtualNetworkPropertiesFormat.Subnets {
+		if subnet.Name == nil || strings.EqualFold(*subnet.Name, name) || subnet.SubnetPropertiesFormat == nil {
+			continue
+		}
+
+		siblingPrefixes := make([]string, 0)
+		if subnet.SubnetPropertiesFormat.AddressPrefix != nil {
+			siblingPrefixes = append(siblingPrefixes, *subnet.SubnetPropertiesFormat.AddressPrefix)
+		}
+		if subnet.SubnetPropertiesFormat.AddressPrefixes != nil {
+			siblingPrefixes = append(siblingPrefixes, *subnet.SubnetPropertiesFormat.AddressPrefixes...)
+		}
+
+		overlap, err := findAddressPrefixOverlap(addressPrefixes, siblingPrefixes)
+		if err != nil {
+			return "", nil, err
+		}
+		if overlap != nil {
+			return *subnet.Name, overlap, nil
+		}
+	}
+
+	return "", nil, nil
+}
+
+// virtualNetworkPeeringAddressSpaceOverlapCustomizeDiff checks, when Enhanced Validation is enabled, that the address
+// space of the remote Virtual Network overlaps neither the address space of the local Virtual Network nor the address
+// space of any Virtual Network which is already peered with the local Virtual Network
+func virtualNetworkPeeringAddressSpaceOverlapCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" || !addressSpaceOverlapEnhancedValidationEnabled {
+		return nil
+	}
+
+	for _, key := range []string{"name", "resource_group_name", "virtual_network_name", "remote_virtual_network_id"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	client := meta.(*clients.Client).Network.VnetClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	localId := parse.NewVirtualNetworkID(subscriptionId, d.Get("resource_group_name").(string), d.Get("virtual_network_name").(string))
+	remoteId, err := parse.VirtualNetworkIDInsensitively(d.Get("remote_virtual_network_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// the Virtual Networks client is scoped to the Subscription of the Provider, so Virtual Networks in other
+	// Subscriptions are left for the API to validate
+	if !strings.EqualFold(remoteId.SubscriptionId, subscriptionId) {
+		return nil
+	}
+
+	local, err := client.Get(ctx, localId.ResourceGroup, localId.Name, "")
+	if err != nil {
+		log.Printf("[DEBUG] unable to retrieve %s to check for overlapping address spaces: %+v", localId, err)
+		return nil
+	}
+	remote, err := client.Get(ctx, remoteId.ResourceGroup, remoteId.Name, "")
+	if err != nil {
+		log.Printf("[DEBUG] unable to retrieve %s to check for overlapping address spaces: %+v", *remoteId, err)
+		return nil
+	}
+	if local.VirtualNetworkPropertiesFormat == nil || remote.VirtualNetworkPropertiesFormat == nil {
+		return nil
+	}
+
+	localAddressSpace, _ := subnetAddressPrefixesFromVirtualNetwork(local)
+	remoteAddressSpace, _ := subnetAddressPrefixesFromVirtualNetwork(remote)
+
+	overlap, err := findAddressPrefixOverlap(remoteAddressSpace, localAddressSpace)
+	if err != nil {
+		return fmt.Errorf("validating the address space of %s: %+v", *remoteId, err)
+	}
+	if overlap != nil {
+		return fmt.Errorf("the address space %q of %s overlaps the address space %q of %s", overlap.first, *remoteId, overlap.second, localId)
+	}
+
+	if local.VirtualNetworkPropertiesFormat.VirtualNetworkPeerings == nil {
+		return nil
+	}
+
+	name := d.Get("name").(string)
+	for _, peering := range *local.VirtualNetworkPropertiesFormat.VirtualNetworkPeerings {
+		if peering.Name == nil || strings.EqualFold(*peering.Name, name) {
+			continue
+		}
+
+		props := peering.VirtualNetworkPeeringPropertiesFormat
+		if props == nil || props.RemoteVirtualNetwork == nil || props.RemoteVirtualNetwork.ID == nil || strings.EqualFold(*props.RemoteVirtualNetwork.ID, remoteId.ID()) {
+			continue
+		}
+
+		overlap, err := findAddressPrefixOverlap(remoteAddressSpace, virtualNetworkPeeringRemoteAddressSpace(props))
+		if err != nil {
+			return fmt.Errorf("validating the address space of %s: %+v", *remoteId, err)
+		}
+		if overlap != nil {
+			return fmt.Errorf("the address space %q of %s overlaps the address space %q of the Virtual Network %q which is already peered with %s", overlap.first, *remoteId, overlap.second, *props.RemoteVirtualNetwork.ID, localId)
+		}
+	}
+
+	return nil
+}
+
+func virtualNetworkPeeringRemoteAddressSpace(input *network.VirtualNetworkPeeringPropertiesFormat) []string {
+	if input == nil {
+		return []string{}
+	}
+
+	// the current address space of the remote Virtual Network takes precedence over the address space which was peered
+	addressSpace := input.RemoteVirtualNetworkAddressSpace
+	if addressSpace == nil || addressSpace.AddressPrefixes == nil {
+		addressSpace = input.RemoteAddressSpace
+	}
+	if addressSpace == nil || addressSpace.AddressPrefixes == nil {
+		return []string{}
+	}
+
+	return *addressSpace.AddressPrefixes
+}
@@ -0,0 +1,161 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestFindAddressPrefixOverlapWithin(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Prefixes []string
+		Expected *addressPrefixOverlap
+		Error    bool
+	}{
+		{
+			Name:     "empty",
+			Prefixes: []string{},
+		},
+		{
+			Name:     "single prefix",
+			Prefixes: []string{"10.0.0.0/16"},
+		},
+		{
+			Name:     "disjoint prefixes",
+			Prefixes: []string{"10.0.0.0/16", "10.1.0.0/16", "ace:cab:deca::/48"},
+		},
+		{
+			Name:     "overlapping prefixes",
+			Prefixes: []string{"10.0.0.0/16", "10.1.0.0/16", "10.1.2.0/24"},
+			Expected: &addressPrefixOverlap{
+				first:  "10.1.0.0/16",
+				second: "10.1.2.0/24",
+			},
+		},
+		{
+			Name:     "unknown values are ignored",
+			Prefixes: []string{"", "10.0.0.0/16", ""},
+		},
+		{
+			Name:     "invalid prefix",
+			Prefixes: []string{"10.0.0.0/16", "10.1.0.0"},
+			Error:    true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := findAddressPrefixOverlapWithin(v.Prefixes)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if v.Expected == nil {
+			if actual != nil {
+				t.Fatalf("Expected no overlap but got %q and %q", actual.first, actual.second)
+			}
+			continue
+		}
+
+		if actual == nil {
+			t.Fatalf("Expected %q and %q to overlap but got no overlap", v.Expected.first, v.Expected.second)
+		}
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %q and %q to overlap but got %q and %q", v.Expected.first, v.Expected.second, actual.first, actual.second)
+		}
+	}
+}
+
+func TestFindSubnetAddressPrefixOverlapWithSiblings(t *testing.T) {
+	vnet := network.VirtualNetwork{
+		VirtualNetworkPropertiesFormat: &network.VirtualNetworkPropertiesFormat{
+			Subnets: &[]network.Subnet{
+				{
+					Name: utils.String("first"),
+					SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
+						AddressPrefix: utils.String("10.0.1.0/24"),
+					},
+				},
+				{
+					Name: utils.String("second"),
+					SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
+						AddressPrefixes: &[]string{"10.0.2.0/24", "ace:cab:deca:deed::/64"},
+					},
+				},
+			},
+		},
+	}
+
+	testData := []struct {
+		Name            string
+		SubnetName      string
+		AddressPrefixes []string
+		Expected        *addressPrefixOverlap
+		ExpectedSibling string
+	}{
+		{
+			Name:            "disjoint",
+			SubnetName:      "third",
+			AddressPrefixes: []string{"10.0.3.0/24"},
+		},
+		{
+			Name:            "overlaps a sibling's address prefix",
+			SubnetName:      "third",
+			AddressPrefixes: []string{"10.0.0.0/23"},
+			Expected: &addressPrefixOverlap{
+				first:  "10.0.0.0/23",
+				second: "10.0.1.0/24",
+			},
+			ExpectedSibling: "first",
+		},
+		{
+			Name:            "overlaps one of a sibling's address prefixes",
+			SubnetName:      "third",
+			AddressPrefixes: []string{"10.0.3.0/24", "ace:cab:deca:deed::/80"},
+			Expected: &addressPrefixOverlap{
+				first:  "ace:cab:deca:deed::/80",
+				second: "ace:cab:deca:deed::/64",
+			},
+			ExpectedSibling: "second",
+		},
+		{
+			// the existing address prefix of the Subnet being validated is excluded
+			Name:            "own address prefix",
+			SubnetName:      "FIRST",
+			AddressPrefixes: []string{"10.0.1.0/25"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		sibling, actual, err := findSubnetAddressPrefixOverlapWithSiblings(v.SubnetName, v.AddressPrefixes, vnet)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.Expected == nil {
+			if actual != nil {
+				t.Fatalf("Expected no overlap but got %q and %q (Subnet %q)", actual.first, actual.second, sibling)
+			}
+			continue
+		}
+
+		if actual == nil {
+			t.Fatalf("Expected %q and %q to overlap but got no overlap", v.Expected.first, v.Expected.second)
+		}
+		if *actual != *v.Expected || sibling != v.ExpectedSibling {
+			t.Fatalf("Expected %q and %q (Subnet %q) to overlap but got %q and %q (Subnet %q)", v.Expected.first, v.Expected.second, v.ExpectedSibling, actual.first, actual.second, sibling)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewayBackendAddressPool() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayBackendAddressPoolCreateUpdate,
+		Read:   resourceApplicationGatewayBackendAddressPoolRead,
+		Update: resourceApplicationGatewayBackendAddressPoolCreateUpdate,
+		Delete: resourceApplicationGatewayBackendAddressPoolDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.BackendAddressPoolID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewayChildResourceSchema(applicationGatewayBackendAddressPoolSchema()),
+	}
+}
+
+func resourceApplicationGatewayBackendAddressPoolCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewBackendAddressPoolID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+
+	pool := expandApplicationGatewayBackendAddressPool(expandApplicationGatewayChildResource(d, applicationGatewayBackendAddressPoolSchema()))
+
+	err = updateApplicationGatewayChildResource(ctx, client, *gatewayId, false, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		pools := make([]network.ApplicationGatewayBackendAddressPool, 0)
+		if props.BackendAddressPools != nil {
+			pools = *props.BackendAddressPools
+		}
+
+		exists := false
+		for i, v := range pools {
+			if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+				if d.IsNewResource() {
+					return tf.ImportAsExistsError("azurerm_application_gateway_backend_address_pool", id.ID())
+				}
+
+				pools[i] = pool
+				exists = true
+			}
+		}
+		if !exists {
+			pools = append(pools, pool)
+		}
+
+		props.BackendAddressPools = &pools
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+	return resourceApplicationGatewayBackendAddressPoolRead(d, meta)
+}
+
+func resourceApplicationGatewayBackendAddressPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendAddressPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", gatewayId, *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var pool *network.ApplicationGatewayBackendAddressPool
+	if props := gateway.ApplicationGatewayPropertiesFormat; props != nil && props.BackendAddressPools != nil {
+		for _, v := range *props.BackendAddressPools {
+			if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+				v := v
+				pool = &v
+				break
+			}
+		}
+	}
+	if pool == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.Name)
+	d.Set("application_gateway_id", gatewayId.ID())
+
+	return setApplicationGatewayChildResource(d, flattenApplicationGatewayBackendAddressPool(*pool), applicationGatewayBackendAddressPoolSchema())
+}
+
+func resourceApplicationGatewayBackendAddressPoolDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackendAddressPoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	return updateApplicationGatewayChildResource(ctx, client, gatewayId, true, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		pools := make([]network.ApplicationGatewayBackendAddressPool, 0)
+		if props.BackendAddressPools != nil {
+			for _, v := range *props.BackendAddressPools {
+				if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+					continue
+				}
+
+				pools = append(pools, v)
+			}
+		}
+
+		props.BackendAddressPools = &pools
+		return nil
+	})
+}
@@ -0,0 +1,246 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// applicationGatewayChildResourceSchema returns the schema for a resource which manages a single block within an
+// Application Gateway, based on the schema of that block within the Application Gateway resource
+func applicationGatewayChildResourceSchema(input map[string]*pluginsdk.Schema) map[string]*pluginsdk.Schema {
+	output := map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"application_gateway_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApplicationGatewayID,
+		},
+	}
+
+	for k, v := range input {
+		// the ID of the block is exposed as the ID of the resource
+		if k == "id" || k == "name" {
+			continue
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
+
+// expandApplicationGatewayChildResource returns the configuration of a child resource in the same format as the
+// block within the Application Gateway resource, so that the same expand functions can be used for both
+func expandApplicationGatewayChildResource(d *pluginsdk.ResourceData, blockSchema map[string]*pluginsdk.Schema) map[string]interface{} {
+	output := make(map[string]interface{})
+	for k := range blockSchema {
+		if k == "id" {
+			continue
+		}
+
+		output[k] = d.Get(k)
+	}
+
+	return output
+}
+
+// setApplicationGatewayChildResource sets the flattened representation of a block within the Application Gateway
+// into the state of the child resource
+func setApplicationGatewayChildResource(d *pluginsdk.ResourceData, input map[string]interface{}, blockSchema map[string]*pluginsdk.Schema) error {
+	for k := range blockSchema {
+		if k == "id" || k == "name" {
+			continue
+		}
+
+		if err := d.Set(k, input[k]); err != nil {
+			return fmt.Errorf("setting `%s`: %+v", k, err)
+		}
+	}
+
+	return nil
+}
+
+// updateApplicationGatewayChildResource retrieves the Application Gateway, applies the specified changes to it and
+// then updates it - whilst holding the same lock as the Application Gateway resource, so that concurrent changes to
+// the Application Gateway aren't lost
+func updateApplicationGatewayChildResource(ctx context.Context, client *network.ApplicationGatewaysClient, id parse.ApplicationGatewayId, ignoreMissing bool, update func(props *network.ApplicationGatewayPropertiesFormat) error) error {
+	locks.ByName(id.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.Name, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if ignoreMissing && utils.ResponseWasNotFound(gateway.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if gateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	if err := update(gateway.ApplicationGatewayPropertiesFormat); err != nil {
+		return err
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, gateway)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// applicationGatewayBlockNames returns the (lower-cased) names of the blocks within the specified list or set
+func applicationGatewayBlockNames(input interface{}) map[string]bool {
+	output := make(map[string]bool)
+
+	var items []interface{}
+	switch v := input.(type) {
+	case []interface{}:
+		items = v
+	case *pluginsdk.Set:
+		items = v.List()
+	}
+
+	for _, raw := range items {
+		item, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if name, ok := item["name"].(string); ok && name != "" {
+			output[strings.ToLower(name)] = true
+		}
+	}
+
+	return output
+}
+
+// filterApplicationGatewayChildManagedBlocks removes the blocks which aren't managed by the Application Gateway
+// resource when `ignore_child_managed_blocks` is enabled - that is, those which aren't defined on it
+func filterApplicationGatewayChildManagedBlocks(d *pluginsdk.ResourceData, key string, input []interface{}) []interface{} {
+	if !d.Get("ignore_child_managed_blocks").(bool) {
+		return input
+	}
+
+	managed := applicationGatewayBlockNames(d.Get(key))
+	output := make([]interface{}, 0)
+	for _, raw := range input {
+		item := raw.(map[string]interface{})
+		if name, ok := item["name"].(string); ok && managed[strings.ToLower(name)] {
+			output = append(output, item)
+		}
+	}
+
+	return output
+}
+
+// mergeApplicationGatewayChildManagedBlocks appends the blocks which exist on the Application Gateway but which aren't
+// managed by the Application Gateway resource (for example those managed by the `azurerm_application_gateway_probe`
+// resource) to the blocks defined on the Application Gateway resource, so that these aren't removed during an update
+func mergeApplicationGatewayChildManagedBlocks(d *pluginsdk.ResourceData, existing network.ApplicationGatewayPropertiesFormat, gateway *network.ApplicationGatewayPropertiesFormat) {
+	isChildManaged := func(key string, name *string) bool {
+		if name == nil {
+			return false
+		}
+
+		managed := applicationGatewayBlockNames(d.Get(key))
+
+		// blocks which have been removed from the configuration were managed by this resource, unless this feature has
+		// only just been enabled, in which case the existing state also contains blocks managed by other resources
+		if oldIgnore, _ := d.GetChange("ignore_child_managed_blocks"); oldIgnore.(bool) {
+			old, _ := d.GetChange(key)
+			for k := range applicationGatewayBlockNames(old) {
+				managed[k] = true
+			}
+		}
+
+		return !managed[strings.ToLower(*name)]
+	}
+
+	if existing.BackendAddressPools != nil {
+		pools := make([]network.ApplicationGatewayBackendAddressPool, 0)
+		if gateway.BackendAddressPools != nil {
+			pools = *gateway.BackendAddressPools
+		}
+		for _, v := range *existing.BackendAddressPools {
+			if isChildManaged("backend_address_pool", v.Name) {
+				pools = append(pools, v)
+			}
+		}
+		gateway.BackendAddressPools = &pools
+	}
+
+	if existing.HTTPListeners != nil {
+		listeners := make([]network.ApplicationGatewayHTTPListener, 0)
+		if gateway.HTTPListeners != nil {
+			listeners = *gateway.HTTPListeners
+		}
+		for _, v := range *existing.HTTPListeners {
+			if isChildManaged("http_listener", v.Name) {
+				listeners = append(listeners, v)
+			}
+		}
+		gateway.HTTPListeners = &listeners
+	}
+
+	if existing.Probes != nil {
+		probes := make([]network.ApplicationGatewayProbe, 0)
+		if gateway.Probes != nil {
+			probes = *gateway.Probes
+		}
+		for _, v := range *existing.Probes {
+			if isChildManaged("probe", v.Name) {
+				probes = append(probes, v)
+			}
+		}
+		gateway.Probes = &probes
+	}
+
+	if existing.RequestRoutingRules != nil {
+		rules := make([]network.ApplicationGatewayRequestRoutingRule, 0)
+		if gateway.RequestRoutingRules != nil {
+			rules = *gateway.RequestRoutingRules
+		}
+		for _, v := range *existing.RequestRoutingRules {
+			if isChildManaged("request_routing_rule", v.Name) {
+				rules = append(rules, v)
+			}
+		}
+		gateway.RequestRoutingRules = &rules
+	}
+
+	if existing.SslCertificates != nil {
+		certificates := make([]network.ApplicationGatewaySslCertificate, 0)
+		if gateway.SslCertificates != nil {
+			certificates = *gateway.SslCertificates
+		}
+		for _, v := range *existing.SslCertificates {
+			if isChildManaged("ssl_certificate", v.Name) {
+				certificates = append(certificates, v)
+			}
+		}
+		gateway.SslCertificates = &certificates
+	}
+}
@@ -0,0 +1,159 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewayProbe() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayProbeCreateUpdate,
+		Read:   resourceApplicationGatewayProbeRead,
+		Update: resourceApplicationGatewayProbeCreateUpdate,
+		Delete: resourceApplicationGatewayProbeDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ProbeID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewayChildResourceSchema(applicationGatewayProbeSchema()),
+	}
+}
+
+func resourceApplicationGatewayProbeCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewProbeID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+
+	probe := expandApplicationGatewayProbe(expandApplicationGatewayChildResource(d, applicationGatewayProbeSchema()))
+	if err := checkApplicationGatewayProbe(probe); err != nil {
+		return err
+	}
+
+	err = updateApplicationGatewayChildResource(ctx, client, *gatewayId, false, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		probes := make([]network.ApplicationGatewayProbe, 0)
+		if props.Probes != nil {
+			probes = *props.Probes
+		}
+
+		exists := false
+		for i, v := range probes {
+			if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+				if d.IsNewResource() {
+					return tf.ImportAsExistsError("azurerm_application_gateway_probe", id.ID())
+				}
+
+				probes[i] = probe
+				exists = true
+			}
+		}
+		if !exists {
+			probes = append(probes, probe)
+		}
+
+		props.Probes = &probes
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+	return resourceApplicationGatewayProbeRead(d, meta)
+}
+
+func resourceApplicationGatewayProbeRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProbeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", gatewayId, *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var probe *network.ApplicationGatewayProbe
+	if props := gateway.ApplicationGatewayPropertiesFormat; props != nil && props.Probes != nil {
+		for _, v := range *props.Probes {
+			if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+				v := v
+				probe = &v
+				break
+			}
+		}
+	}
+	if probe == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.Name)
+	d.Set("application_gateway_id", gatewayId.ID())
+
+	return setApplicationGatewayChildResource(d, flattenApplicationGatewayProbe(*probe), applicationGatewayProbeSchema())
+}
+
+func resourceApplicationGatewayProbeDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProbeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	return updateApplicationGatewayChildResource(ctx, client, gatewayId, true, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		probes := make([]network.ApplicationGatewayProbe, 0)
+		if props.Probes != nil {
+			for _, v := range *props.Probes {
+				if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+					continue
+				}
+
+				probes = append(probes, v)
+			}
+		}
+
+		props.Probes = &probes
+		return nil
+	})
+}
@@ -0,0 +1,149 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationGatewayProbeResource struct{}
+
+func TestAccApplicationGatewayProbe_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_probe", "test")
+	r := ApplicationGatewayProbeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayProbe_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_probe", "test")
+	r := ApplicationGatewayProbeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewayProbe_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_probe", "test")
+	r := ApplicationGatewayProbeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t ApplicationGatewayProbeResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ProbeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.Probes != nil {
+		for _, v := range *props.Probes {
+			if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r ApplicationGatewayProbeResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_probe" "test" {
+  name                   = "acctest-probe-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+  protocol               = "Http"
+  path                   = "/test"
+  host                   = "azure.com"
+  interval               = 30
+  timeout                = 30
+  unhealthy_threshold    = 3
+}
+`, ApplicationGatewayResource{}.ignoreChildManagedBlocks(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewayProbeResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_probe" "import" {
+  name                   = azurerm_application_gateway_probe.test.name
+  application_gateway_id = azurerm_application_gateway_probe.test.application_gateway_id
+  protocol               = azurerm_application_gateway_probe.test.protocol
+  path                   = azurerm_application_gateway_probe.test.path
+  host                   = azurerm_application_gateway_probe.test.host
+  interval               = azurerm_application_gateway_probe.test.interval
+  timeout                = azurerm_application_gateway_probe.test.timeout
+  unhealthy_threshold    = azurerm_application_gateway_probe.test.unhealthy_threshold
+}
+`, r.basic(data))
+}
+
+func (r ApplicationGatewayProbeResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_probe" "test" {
+  name                   = "acctest-probe-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+  protocol               = "Http"
+  path                   = "/health"
+  host                   = "azure.com"
+  interval               = 60
+  timeout                = 60
+  unhealthy_threshold    = 5
+}
+`, ApplicationGatewayResource{}.ignoreChildManagedBlocks(data), data.RandomInteger)
+}
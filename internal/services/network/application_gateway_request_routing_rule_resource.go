@@ -0,0 +1,164 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewayRequestRoutingRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayRequestRoutingRuleCreateUpdate,
+		Read:   resourceApplicationGatewayRequestRoutingRuleRead,
+		Update: resourceApplicationGatewayRequestRoutingRuleCreateUpdate,
+		Delete: resourceApplicationGatewayRequestRoutingRuleDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.RequestRoutingRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewayChildResourceSchema(applicationGatewayRequestRoutingRuleSchema()),
+	}
+}
+
+func resourceApplicationGatewayRequestRoutingRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewRequestRoutingRuleID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+
+	rule, err := expandApplicationGatewayRequestRoutingRule(expandApplicationGatewayChildResource(d, applicationGatewayRequestRoutingRuleSchema()), gatewayId.ID())
+	if err != nil {
+		return fmt.Errorf("expanding %s: %+v", id, err)
+	}
+
+	err = updateApplicationGatewayChildResource(ctx, client, *gatewayId, false, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		rules := make([]network.ApplicationGatewayRequestRoutingRule, 0)
+		if props.RequestRoutingRules != nil {
+			rules = *props.RequestRoutingRules
+		}
+
+		exists := false
+		for i, v := range rules {
+			if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+				if d.IsNewResource() {
+					return tf.ImportAsExistsError("azurerm_application_gateway_request_routing_rule", id.ID())
+				}
+
+				rules[i] = *rule
+				exists = true
+			}
+		}
+		if !exists {
+			rules = append(rules, *rule)
+		}
+
+		props.RequestRoutingRules = &rules
+		return checkApplicationGatewayRequestRoutingRulePriorities(rules)
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+	return resourceApplicationGatewayRequestRoutingRuleRead(d, meta)
+}
+
+func resourceApplicationGatewayRequestRoutingRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RequestRoutingRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", gatewayId, *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var rule *network.ApplicationGatewayRequestRoutingRule
+	if props := gateway.ApplicationGatewayPropertiesFormat; props != nil && props.RequestRoutingRules != nil {
+		for _, v := range *props.RequestRoutingRules {
+			if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+				v := v
+				rule = &v
+				break
+			}
+		}
+	}
+	if rule == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.Name)
+	d.Set("application_gateway_id", gatewayId.ID())
+
+	output, err := flattenApplicationGatewayRequestRoutingRule(*rule)
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", *id, err)
+	}
+
+	return setApplicationGatewayChildResource(d, output, applicationGatewayRequestRoutingRuleSchema())
+}
+
+func resourceApplicationGatewayRequestRoutingRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RequestRoutingRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	return updateApplicationGatewayChildResource(ctx, client, gatewayId, true, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		rules := make([]network.ApplicationGatewayRequestRoutingRule, 0)
+		if props.RequestRoutingRules != nil {
+			for _, v := range *props.RequestRoutingRules {
+				if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+					continue
+				}
+
+				rules = append(rules, v)
+			}
+		}
+
+		props.RequestRoutingRules = &rules
+		return nil
+	})
+}
@@ -0,0 +1,107 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationGatewayRequestRoutingRuleResource struct{}
+
+func TestAccApplicationGatewayRequestRoutingRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_request_routing_rule", "test")
+	r := ApplicationGatewayRequestRoutingRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayRequestRoutingRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_request_routing_rule", "test")
+	r := ApplicationGatewayRequestRoutingRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (t ApplicationGatewayRequestRoutingRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.RequestRoutingRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.RequestRoutingRules != nil {
+		for _, v := range *props.RequestRoutingRules {
+			if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r ApplicationGatewayRequestRoutingRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_http_listener" "test" {
+  name                           = "acctest-httplstn-%d"
+  application_gateway_id         = azurerm_application_gateway.test.id
+  frontend_ip_configuration_name = local.frontend_ip_configuration_name
+  frontend_port_name             = local.frontend_port_2_name
+  protocol                       = "Http"
+}
+
+resource "azurerm_application_gateway_request_routing_rule" "test" {
+  name                       = "acctest-rqrt-%d"
+  application_gateway_id     = azurerm_application_gateway.test.id
+  rule_type                  = "Basic"
+  http_listener_name         = azurerm_application_gateway_http_listener.test.name
+  backend_address_pool_name  = local.backend_address_pool_name
+  backend_http_settings_name = local.http_setting_name
+}
+`, ApplicationGatewayResource{}.ignoreChildManagedBlocks(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r ApplicationGatewayRequestRoutingRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_request_routing_rule" "import" {
+  name                       = azurerm_application_gateway_request_routing_rule.test.name
+  application_gateway_id     = azurerm_application_gateway_request_routing_rule.test.application_gateway_id
+  rule_type                  = azurerm_application_gateway_request_routing_rule.test.rule_type
+  http_listener_name         = azurerm_application_gateway_request_routing_rule.test.http_listener_name
+  backend_address_pool_name  = azurerm_application_gateway_request_routing_rule.test.backend_address_pool_name
+  backend_http_settings_name = azurerm_application_gateway_request_routing_rule.test.backend_http_settings_name
+}
+`, r.basic(data))
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	msiParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
@@ -24,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+var applicationGatewayResourceName = "azurerm_application_gateway"
+
 // See https://github.com/Azure/azure-sdk-for-go/blob/master/services/network/mgmt/2018-04-01/network/models.go
 func possibleApplicationGatewaySslCipherSuiteValues() []string {
 	cipherSuites := make([]string, 0)
@@ -167,36 +170,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeList,
 				Required: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"fqdns": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							MinItems: 1,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validation.NoZeroValues,
-							},
-						},
-
-						"ip_addresses": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validate.IPv4Address,
-							},
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewayBackendAddressPoolSchema(),
 				},
 			},
 
@@ -431,118 +405,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeList,
 				Required: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"frontend_ip_configuration_name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"frontend_port_name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"protocol": {
-							Type:             pluginsdk.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ProtocolHTTP),
-								string(network.ProtocolHTTPS),
-							}, true),
-						},
-
-						"host_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"host_names": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validation.StringIsNotEmpty,
-							},
-						},
-
-						"ssl_certificate_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"require_sni": {
-							Type:     pluginsdk.TypeBool,
-							Optional: true,
-						},
-
-						"frontend_ip_configuration_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"frontend_port_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"ssl_certificate_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"ssl_profile_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"custom_error_configuration": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"status_code": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-										ValidateFunc: validation.StringInSlice([]string{
-											string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus403),
-											string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus502),
-										}, false),
-									},
-
-									"custom_error_page_url": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-									},
-
-									"id": {
-										Type:     pluginsdk.TypeString,
-										Computed: true,
-									},
-								},
-							},
-						},
-
-						"firewall_policy_id": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: azure.ValidateResourceID,
-						},
-
-						"ssl_profile_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-					},
+					Schema: applicationGatewayHTTPListenerSchema(),
 				},
 			},
 
@@ -551,94 +414,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Required: true,
 				MinItems: 1,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"rule_type": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ApplicationGatewayRequestRoutingRuleTypeBasic),
-								string(network.ApplicationGatewayRequestRoutingRuleTypePathBasedRouting),
-							}, false),
-						},
-
-						"http_listener_name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"backend_address_pool_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"backend_http_settings_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"url_path_map_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"redirect_configuration_name": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"rewrite_rule_set_name": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"priority": {
-							Type:         pluginsdk.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntBetween(1, 20000),
-						},
-
-						"backend_address_pool_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"backend_http_settings_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"http_listener_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"url_path_map_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"redirect_configuration_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"rewrite_rule_set_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewayRequestRoutingRuleSchema(),
 				},
 			},
 
@@ -832,94 +608,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"protocol": {
-							Type:             pluginsdk.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ProtocolHTTP),
-								string(network.ProtocolHTTPS),
-							}, true),
-						},
-
-						"path": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"host": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"interval": {
-							Type:     pluginsdk.TypeInt,
-							Required: true,
-						},
-
-						"timeout": {
-							Type:     pluginsdk.TypeInt,
-							Required: true,
-						},
-
-						"unhealthy_threshold": {
-							Type:     pluginsdk.TypeInt,
-							Required: true,
-						},
-
-						"port": {
-							Type:         pluginsdk.TypeInt,
-							Optional:     true,
-							ValidateFunc: validate.PortNumber,
-						},
-
-						"pick_host_name_from_backend_http_settings": {
-							Type:     pluginsdk.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-
-						"minimum_servers": {
-							Type:     pluginsdk.TypeInt,
-							Optional: true,
-							Default:  0,
-						},
-
-						//lintignore:XS003
-						"match": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Computed: true,
-							MaxItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"body": {
-										Type:     pluginsdk.TypeString,
-										Optional: true,
-									},
-
-									"status_code": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-										},
-									},
-								},
-							},
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewayProbeSchema(),
 				},
 			},
 
@@ -1051,41 +740,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"data": {
-							Type:      pluginsdk.TypeString,
-							Optional:  true,
-							Sensitive: true,
-							StateFunc: base64EncodedStateFunc,
-						},
-
-						"password": {
-							Type:      pluginsdk.TypeString,
-							Optional:  true,
-							Sensitive: true,
-						},
-
-						"key_vault_secret_id": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"public_cert_data": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewaySslCertificateSchema(),
 				},
 			},
 
@@ -1400,6 +1055,12 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				ValidateFunc: azure.ValidateResourceID,
 			},
 
+			"ignore_child_managed_blocks": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"custom_error_configuration": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -1434,6 +1095,374 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 	}
 }
 
+func applicationGatewayBackendAddressPoolSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"fqdns": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+
+		"ip_addresses": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.IPv4Address,
+			},
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func applicationGatewayHTTPListenerSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"frontend_ip_configuration_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"frontend_port_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"protocol": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppress.CaseDifference,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.ProtocolHTTP),
+				string(network.ProtocolHTTPS),
+			}, true),
+		},
+
+		"host_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"host_names": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"ssl_certificate_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"require_sni": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"frontend_ip_configuration_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"frontend_port_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"ssl_certificate_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"ssl_profile_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"custom_error_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"status_code": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus403),
+							string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus502),
+						}, false),
+					},
+
+					"custom_error_page_url": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"firewall_policy_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"ssl_profile_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func applicationGatewayRequestRoutingRuleSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"rule_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.ApplicationGatewayRequestRoutingRuleTypeBasic),
+				string(network.ApplicationGatewayRequestRoutingRuleTypePathBasedRouting),
+			}, false),
+		},
+
+		"http_listener_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"backend_address_pool_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"backend_http_settings_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"url_path_map_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"redirect_configuration_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"rewrite_rule_set_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"priority": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 20000),
+		},
+
+		"backend_address_pool_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"backend_http_settings_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"http_listener_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"url_path_map_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"redirect_configuration_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"rewrite_rule_set_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func applicationGatewayProbeSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"protocol": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppress.CaseDifference,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.ProtocolHTTP),
+				string(network.ProtocolHTTPS),
+			}, true),
+		},
+
+		"path": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"host": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"interval": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+		},
+
+		"timeout": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+		},
+
+		"unhealthy_threshold": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+		},
+
+		"port": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validate.PortNumber,
+		},
+
+		"pick_host_name_from_backend_http_settings": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"minimum_servers": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Default:  0,
+		},
+
+		//lintignore:XS003
+		"match": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"body": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+
+					"status_code": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func applicationGatewaySslCertificateSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"data": {
+			Type:      pluginsdk.TypeString,
+			Optional:  true,
+			Sensitive: true,
+			StateFunc: base64EncodedStateFunc,
+		},
+
+		"password": {
+			Type:      pluginsdk.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+
+		"key_vault_secret_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"public_cert_data": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
 func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -1456,6 +1485,9 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	locks.ByName(id.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.Name, applicationGatewayResourceName)
+
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	enablehttp2 := d.Get("enable_http2").(bool)
 	t := d.Get("tags").(map[string]interface{})
@@ -1551,18 +1583,8 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	}
 
 	for _, probe := range *gateway.ApplicationGatewayPropertiesFormat.Probes {
-		if props := probe.ApplicationGatewayProbePropertiesFormat; props != nil {
-			if props.Host == nil || props.PickHostNameFromBackendHTTPSettings == nil {
-				continue
-			}
-
-			if *props.Host == "" && !*props.PickHostNameFromBackendHTTPSettings {
-				return fmt.Errorf("One of `host` or `pick_host_name_from_backend_http_settings` must be set")
-			}
-
-			if *props.Host != "" && *props.PickHostNameFromBackendHTTPSettings {
-				return fmt.Errorf("Only one of `host` or `pick_host_name_from_backend_http_settings` can be set")
-			}
+		if err := checkApplicationGatewayProbe(probe); err != nil {
+			return err
 		}
 	}
 
@@ -1584,6 +1606,17 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	if !d.IsNewResource() && d.Get("ignore_child_managed_blocks").(bool) {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if existing.ApplicationGatewayPropertiesFormat != nil {
+			mergeApplicationGatewayChildManagedBlocks(d, *existing.ApplicationGatewayPropertiesFormat, gateway.ApplicationGatewayPropertiesFormat)
+		}
+	}
+
 	if stopApplicationGateway {
 		future, err := client.Stop(ctx, id.ResourceGroup, id.Name)
 		if err != nil {
@@ -1664,7 +1697,7 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `trusted_root_certificate`: %+v", err)
 		}
 
-		if setErr := d.Set("backend_address_pool", flattenApplicationGatewayBackendAddressPools(props.BackendAddressPools)); setErr != nil {
+		if setErr := d.Set("backend_address_pool", filterApplicationGatewayChildManagedBlocks(d, "backend_address_pool", flattenApplicationGatewayBackendAddressPools(props.BackendAddressPools))); setErr != nil {
 			return fmt.Errorf("setting `backend_address_pool`: %+v", setErr)
 		}
 
@@ -1686,7 +1719,7 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 		if err != nil {
 			return fmt.Errorf("flattening `http_listener`: %+v", err)
 		}
-		if setErr := d.Set("http_listener", httpListeners); setErr != nil {
+		if setErr := d.Set("http_listener", filterApplicationGatewayChildManagedBlocks(d, "http_listener", httpListeners)); setErr != nil {
 			return fmt.Errorf("setting `http_listener`: %+v", setErr)
 		}
 
@@ -1702,7 +1735,7 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `gateway_ip_configuration`: %+v", setErr)
 		}
 
-		if setErr := d.Set("probe", flattenApplicationGatewayProbes(props.Probes)); setErr != nil {
+		if setErr := d.Set("probe", filterApplicationGatewayChildManagedBlocks(d, "probe", flattenApplicationGatewayProbes(props.Probes))); setErr != nil {
 			return fmt.Errorf("setting `probe`: %+v", setErr)
 		}
 
@@ -1710,7 +1743,7 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 		if err != nil {
 			return fmt.Errorf("flattening `request_routing_rule`: %+v", err)
 		}
-		if setErr := d.Set("request_routing_rule", requestRoutingRules); setErr != nil {
+		if setErr := d.Set("request_routing_rule", filterApplicationGatewayChildManagedBlocks(d, "request_routing_rule", requestRoutingRules)); setErr != nil {
 			return fmt.Errorf("setting `request_routing_rule`: %+v", setErr)
 		}
 
@@ -1735,7 +1768,7 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `autoscale_configuration`: %+v", setErr)
 		}
 
-		if setErr := d.Set("ssl_certificate", flattenApplicationGatewaySslCertificates(props.SslCertificates, d)); setErr != nil {
+		if setErr := d.Set("ssl_certificate", filterApplicationGatewayChildManagedBlocks(d, "ssl_certificate", flattenApplicationGatewaySslCertificates(props.SslCertificates, d))); setErr != nil {
 			return fmt.Errorf("setting `ssl_certificate`: %+v", setErr)
 		}
 
@@ -1981,32 +2014,33 @@ func expandApplicationGatewayBackendAddressPools(d *pluginsdk.ResourceData) *[]n
 	results := make([]network.ApplicationGatewayBackendAddressPool, 0)
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
-		backendAddresses := make([]network.ApplicationGatewayBackendAddress, 0)
+		results = append(results, expandApplicationGatewayBackendAddressPool(raw.(map[string]interface{})))
+	}
 
-		for _, ip := range v["fqdns"].([]interface{}) {
-			backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
-				Fqdn: utils.String(ip.(string)),
-			})
-		}
-		for _, ip := range v["ip_addresses"].([]interface{}) {
-			backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
-				IPAddress: utils.String(ip.(string)),
-			})
-		}
+	return &results
+}
 
-		name := v["name"].(string)
-		output := network.ApplicationGatewayBackendAddressPool{
-			Name: utils.String(name),
-			ApplicationGatewayBackendAddressPoolPropertiesFormat: &network.ApplicationGatewayBackendAddressPoolPropertiesFormat{
-				BackendAddresses: &backendAddresses,
-			},
-		}
+func expandApplicationGatewayBackendAddressPool(v map[string]interface{}) network.ApplicationGatewayBackendAddressPool {
+	backendAddresses := make([]network.ApplicationGatewayBackendAddress, 0)
 
-		results = append(results, output)
+	for _, ip := range v["fqdns"].([]interface{}) {
+		backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
+			Fqdn: utils.String(ip.(string)),
+		})
+	}
+	for _, ip := range v["ip_addresses"].([]interface{}) {
+		backendAddresses = append(backendAddresses, network.ApplicationGatewayBackendAddress{
+			IPAddress: utils.String(ip.(string)),
+		})
 	}
 
-	return &results
+	name := v["name"].(string)
+	return network.ApplicationGatewayBackendAddressPool{
+		Name: utils.String(name),
+		ApplicationGatewayBackendAddressPoolPropertiesFormat: &network.ApplicationGatewayBackendAddressPoolPropertiesFormat{
+			BackendAddresses: &backendAddresses,
+		},
+	}
 }
 
 func flattenApplicationGatewayBackendAddressPools(input *[]network.ApplicationGatewayBackendAddressPool) []interface{} {
@@ -2016,38 +2050,42 @@ func flattenApplicationGatewayBackendAddressPools(input *[]network.ApplicationGa
 	}
 
 	for _, config := range *input {
-		ipAddressList := make([]interface{}, 0)
-		fqdnList := make([]interface{}, 0)
-
-		if props := config.ApplicationGatewayBackendAddressPoolPropertiesFormat; props != nil {
-			if props.BackendAddresses != nil {
-				for _, address := range *props.BackendAddresses {
-					if address.IPAddress != nil {
-						ipAddressList = append(ipAddressList, *address.IPAddress)
-					} else if address.Fqdn != nil {
-						fqdnList = append(fqdnList, *address.Fqdn)
-					}
+		results = append(results, flattenApplicationGatewayBackendAddressPool(config))
+	}
+
+	return results
+}
+
+func flattenApplicationGatewayBackendAddressPool(config network.ApplicationGatewayBackendAddressPool) map[string]interface{} {
+	ipAddressList := make([]interface{}, 0)
+	fqdnList := make([]interface{}, 0)
+
+	if props := config.ApplicationGatewayBackendAddressPoolPropertiesFormat; props != nil {
+		if props.BackendAddresses != nil {
+			for _, address := range *props.BackendAddresses {
+				if address.IPAddress != nil {
+					ipAddressList = append(ipAddressList, *address.IPAddress)
+				} else if address.Fqdn != nil {
+					fqdnList = append(fqdnList, *address.Fqdn)
 				}
 			}
 		}
+	}
 
-		output := map[string]interface{}{
-			"fqdns":        fqdnList,
-			"ip_addresses": ipAddressList,
-		}
-
-		if config.ID != nil {
-			output["id"] = *config.ID
-		}
+	output := map[string]interface{}{
+		"fqdns":        fqdnList,
+		"ip_addresses": ipAddressList,
+	}
 
-		if config.Name != nil {
-			output["name"] = *config.Name
-		}
+	if config.ID != nil {
+		output["id"] = *config.ID
+	}
 
-		results = append(results, output)
+	if config.Name != nil {
+		output["name"] = *config.Name
 	}
 
-	return results
+	return output
 }
 
 func expandApplicationGatewayBackendHTTPSettings(d *pluginsdk.ResourceData, gatewayID string) *[]network.ApplicationGatewayBackendHTTPSettings {
@@ -2352,75 +2390,83 @@ func expandApplicationGatewayHTTPListeners(d *pluginsdk.ResourceData, gatewayID
 	results := make([]network.ApplicationGatewayHTTPListener, 0)
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
+		listener, err := expandApplicationGatewayHTTPListener(raw.(map[string]interface{}), gatewayID)
+		if err != nil {
+			return nil, err
+		}
 
-		name := v["name"].(string)
-		frontendIPConfigName := v["frontend_ip_configuration_name"].(string)
-		frontendPortName := v["frontend_port_name"].(string)
-		protocol := v["protocol"].(string)
-		requireSNI := v["require_sni"].(bool)
-		sslProfileName := v["ssl_profile_name"].(string)
+		results = append(results, *listener)
+	}
 
-		frontendIPConfigID := fmt.Sprintf("%s/frontendIPConfigurations/%s", gatewayID, frontendIPConfigName)
-		frontendPortID := fmt.Sprintf("%s/frontendPorts/%s", gatewayID, frontendPortName)
-		firewallPolicyID := v["firewall_policy_id"].(string)
+	return &results, nil
+}
 
-		customErrorConfigurations := expandApplicationGatewayCustomErrorConfigurations(v["custom_error_configuration"].([]interface{}))
+func expandApplicationGatewayHTTPListener(v map[string]interface{}, gatewayID string) (*network.ApplicationGatewayHTTPListener, error) {
 
-		listener := network.ApplicationGatewayHTTPListener{
-			Name: utils.String(name),
-			ApplicationGatewayHTTPListenerPropertiesFormat: &network.ApplicationGatewayHTTPListenerPropertiesFormat{
-				FrontendIPConfiguration: &network.SubResource{
-					ID: utils.String(frontendIPConfigID),
-				},
-				FrontendPort: &network.SubResource{
-					ID: utils.String(frontendPortID),
-				},
-				Protocol:                    network.ApplicationGatewayProtocol(protocol),
-				RequireServerNameIndication: utils.Bool(requireSNI),
-				CustomErrorConfigurations:   customErrorConfigurations,
+	name := v["name"].(string)
+	frontendIPConfigName := v["frontend_ip_configuration_name"].(string)
+	frontendPortName := v["frontend_port_name"].(string)
+	protocol := v["protocol"].(string)
+	requireSNI := v["require_sni"].(bool)
+	sslProfileName := v["ssl_profile_name"].(string)
+
+	frontendIPConfigID := fmt.Sprintf("%s/frontendIPConfigurations/%s", gatewayID, frontendIPConfigName)
+	frontendPortID := fmt.Sprintf("%s/frontendPorts/%s", gatewayID, frontendPortName)
+	firewallPolicyID := v["firewall_policy_id"].(string)
+
+	customErrorConfigurations := expandApplicationGatewayCustomErrorConfigurations(v["custom_error_configuration"].([]interface{}))
+
+	listener := network.ApplicationGatewayHTTPListener{
+		Name: utils.String(name),
+		ApplicationGatewayHTTPListenerPropertiesFormat: &network.ApplicationGatewayHTTPListenerPropertiesFormat{
+			FrontendIPConfiguration: &network.SubResource{
+				ID: utils.String(frontendIPConfigID),
 			},
-		}
+			FrontendPort: &network.SubResource{
+				ID: utils.String(frontendPortID),
+			},
+			Protocol:                    network.ApplicationGatewayProtocol(protocol),
+			RequireServerNameIndication: utils.Bool(requireSNI),
+			CustomErrorConfigurations:   customErrorConfigurations,
+		},
+	}
 
-		host := v["host_name"].(string)
-		hosts := v["host_names"].(*pluginsdk.Set).List()
+	host := v["host_name"].(string)
+	hosts := v["host_names"].(*pluginsdk.Set).List()
 
-		if host != "" && len(hosts) > 0 {
-			return nil, fmt.Errorf("`host_name` and `host_names` cannot be specified together")
-		}
+	if host != "" && len(hosts) > 0 {
+		return nil, fmt.Errorf("`host_name` and `host_names` cannot be specified together")
+	}
 
-		if host != "" {
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostName = &host
-		}
+	if host != "" {
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostName = &host
+	}
 
-		if len(hosts) > 0 {
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostNames = utils.ExpandStringSlice(hosts)
-		}
+	if len(hosts) > 0 {
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostNames = utils.ExpandStringSlice(hosts)
+	}
 
-		if sslCertName := v["ssl_certificate_name"].(string); sslCertName != "" {
-			certID := fmt.Sprintf("%s/sslCertificates/%s", gatewayID, sslCertName)
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.SslCertificate = &network.SubResource{
-				ID: utils.String(certID),
-			}
+	if sslCertName := v["ssl_certificate_name"].(string); sslCertName != "" {
+		certID := fmt.Sprintf("%s/sslCertificates/%s", gatewayID, sslCertName)
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.SslCertificate = &network.SubResource{
+			ID: utils.String(certID),
 		}
+	}
 
-		if firewallPolicyID != "" && len(firewallPolicyID) > 0 {
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.FirewallPolicy = &network.SubResource{
-				ID: utils.String(firewallPolicyID),
-			}
+	if firewallPolicyID != "" && len(firewallPolicyID) > 0 {
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.FirewallPolicy = &network.SubResource{
+			ID: utils.String(firewallPolicyID),
 		}
+	}
 
-		if sslProfileName != "" && len(sslProfileName) > 0 {
-			sslProfileID := fmt.Sprintf("%s/sslProfiles/%s", gatewayID, sslProfileName)
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.SslProfile = &network.SubResource{
-				ID: utils.String(sslProfileID),
-			}
+	if sslProfileName != "" && len(sslProfileName) > 0 {
+		sslProfileID := fmt.Sprintf("%s/sslProfiles/%s", gatewayID, sslProfileName)
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.SslProfile = &network.SubResource{
+			ID: utils.String(sslProfileID),
 		}
-
-		results = append(results, listener)
 	}
 
-	return &results, nil
+	return &listener, nil
 }
 
 func flattenApplicationGatewayHTTPListeners(input *[]network.ApplicationGatewayHTTPListener) ([]interface{}, error) {
@@ -2430,88 +2476,97 @@ func flattenApplicationGatewayHTTPListeners(input *[]network.ApplicationGatewayH
 	}
 
 	for _, v := range *input {
-		output := map[string]interface{}{}
-
-		if v.ID != nil {
-			output["id"] = *v.ID
+		output, err := flattenApplicationGatewayHTTPListener(v)
+		if err != nil {
+			return nil, err
 		}
 
-		if v.Name != nil {
-			output["name"] = *v.Name
-		}
+		results = append(results, output)
+	}
 
-		if props := v.ApplicationGatewayHTTPListenerPropertiesFormat; props != nil {
-			if port := props.FrontendPort; port != nil {
-				if port.ID != nil {
-					portId, err := parse.FrontendPortID(*port.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["frontend_port_name"] = portId.Name
-					output["frontend_port_id"] = portId.ID()
-				}
-			}
+	return results, nil
+}
 
-			if feConfig := props.FrontendIPConfiguration; feConfig != nil {
-				if feConfig.ID != nil {
-					feConfigId, err := parse.FrontendIPConfigurationID(*feConfig.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["frontend_ip_configuration_name"] = feConfigId.Name
-					output["frontend_ip_configuration_id"] = feConfigId.ID()
+func flattenApplicationGatewayHTTPListener(v network.ApplicationGatewayHTTPListener) (map[string]interface{}, error) {
+	output := map[string]interface{}{}
+
+	if v.ID != nil {
+		output["id"] = *v.ID
+	}
+
+	if v.Name != nil {
+		output["name"] = *v.Name
+	}
+
+	if props := v.ApplicationGatewayHTTPListenerPropertiesFormat; props != nil {
+		if port := props.FrontendPort; port != nil {
+			if port.ID != nil {
+				portId, err := parse.FrontendPortID(*port.ID)
+				if err != nil {
+					return nil, err
 				}
+				output["frontend_port_name"] = portId.Name
+				output["frontend_port_id"] = portId.ID()
 			}
+		}
 
-			if hostname := props.HostName; hostname != nil {
-				output["host_name"] = *hostname
+		if feConfig := props.FrontendIPConfiguration; feConfig != nil {
+			if feConfig.ID != nil {
+				feConfigId, err := parse.FrontendIPConfigurationID(*feConfig.ID)
+				if err != nil {
+					return nil, err
+				}
+				output["frontend_ip_configuration_name"] = feConfigId.Name
+				output["frontend_ip_configuration_id"] = feConfigId.ID()
 			}
+		}
 
-			if hostnames := props.HostNames; hostnames != nil {
-				output["host_names"] = utils.FlattenStringSlice(hostnames)
-			}
+		if hostname := props.HostName; hostname != nil {
+			output["host_name"] = *hostname
+		}
 
-			output["protocol"] = string(props.Protocol)
+		if hostnames := props.HostNames; hostnames != nil {
+			output["host_names"] = utils.FlattenStringSlice(hostnames)
+		}
 
-			if cert := props.SslCertificate; cert != nil {
-				if cert.ID != nil {
-					certId, err := parse.SslCertificateID(*cert.ID)
-					if err != nil {
-						return nil, err
-					}
+		output["protocol"] = string(props.Protocol)
 
-					output["ssl_certificate_name"] = certId.Name
-					output["ssl_certificate_id"] = certId.ID()
+		if cert := props.SslCertificate; cert != nil {
+			if cert.ID != nil {
+				certId, err := parse.SslCertificateID(*cert.ID)
+				if err != nil {
+					return nil, err
 				}
-			}
 
-			if sni := props.RequireServerNameIndication; sni != nil {
-				output["require_sni"] = *sni
+				output["ssl_certificate_name"] = certId.Name
+				output["ssl_certificate_id"] = certId.ID()
 			}
+		}
 
-			if fwp := props.FirewallPolicy; fwp != nil && fwp.ID != nil {
-				output["firewall_policy_id"] = *fwp.ID
-			}
+		if sni := props.RequireServerNameIndication; sni != nil {
+			output["require_sni"] = *sni
+		}
 
-			if sslp := props.SslProfile; sslp != nil {
-				if sslp.ID != nil {
-					sslProfileId, err := parse.SslProfileID(*sslp.ID)
-					if err != nil {
-						return nil, err
-					}
+		if fwp := props.FirewallPolicy; fwp != nil && fwp.ID != nil {
+			output["firewall_policy_id"] = *fwp.ID
+		}
 
-					output["ssl_profile_name"] = sslProfileId.Name
-					output["ssl_profile_id"] = sslProfileId.ID()
+		if sslp := props.SslProfile; sslp != nil {
+			if sslp.ID != nil {
+				sslProfileId, err := parse.SslProfileID(*sslp.ID)
+				if err != nil {
+					return nil, err
 				}
-			}
 
-			output["custom_error_configuration"] = flattenApplicationGatewayCustomErrorConfigurations(props.CustomErrorConfigurations)
+				output["ssl_profile_name"] = sslProfileId.Name
+				output["ssl_profile_id"] = sslProfileId.ID()
+			}
 		}
 
-		results = append(results, output)
+		output["custom_error_configuration"] = flattenApplicationGatewayCustomErrorConfigurations(props.CustomErrorConfigurations)
 	}
 
-	return results, nil
+	return output, nil
 }
 
 func expandApplicationGatewayIPConfigurations(d *pluginsdk.ResourceData) (*[]network.ApplicationGatewayIPConfiguration, bool) {
@@ -2733,59 +2788,78 @@ func expandApplicationGatewayProbes(d *pluginsdk.ResourceData) *[]network.Applic
 	results := make([]network.ApplicationGatewayProbe, 0)
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
+		results = append(results, expandApplicationGatewayProbe(raw.(map[string]interface{})))
+	}
 
-		host := v["host"].(string)
-		interval := int32(v["interval"].(int))
-		minServers := int32(v["minimum_servers"].(int))
-		name := v["name"].(string)
-		probePath := v["path"].(string)
-		protocol := v["protocol"].(string)
-		port := int32(v["port"].(int))
-		timeout := int32(v["timeout"].(int))
-		unhealthyThreshold := int32(v["unhealthy_threshold"].(int))
-		pickHostNameFromBackendHTTPSettings := v["pick_host_name_from_backend_http_settings"].(bool)
+	return &results
+}
 
-		output := network.ApplicationGatewayProbe{
-			Name: utils.String(name),
-			ApplicationGatewayProbePropertiesFormat: &network.ApplicationGatewayProbePropertiesFormat{
-				Host:                                utils.String(host),
-				Interval:                            utils.Int32(interval),
-				MinServers:                          utils.Int32(minServers),
-				Path:                                utils.String(probePath),
-				Protocol:                            network.ApplicationGatewayProtocol(protocol),
-				Timeout:                             utils.Int32(timeout),
-				UnhealthyThreshold:                  utils.Int32(unhealthyThreshold),
-				PickHostNameFromBackendHTTPSettings: utils.Bool(pickHostNameFromBackendHTTPSettings),
-			},
-		}
+func expandApplicationGatewayProbe(v map[string]interface{}) network.ApplicationGatewayProbe {
+	host := v["host"].(string)
+	interval := int32(v["interval"].(int))
+	minServers := int32(v["minimum_servers"].(int))
+	name := v["name"].(string)
+	probePath := v["path"].(string)
+	protocol := v["protocol"].(string)
+	port := int32(v["port"].(int))
+	timeout := int32(v["timeout"].(int))
+	unhealthyThreshold := int32(v["unhealthy_threshold"].(int))
+	pickHostNameFromBackendHTTPSettings := v["pick_host_name_from_backend_http_settings"].(bool)
+
+	output := network.ApplicationGatewayProbe{
+		Name: utils.String(name),
+		ApplicationGatewayProbePropertiesFormat: &network.ApplicationGatewayProbePropertiesFormat{
+			Host:                                utils.String(host),
+			Interval:                            utils.Int32(interval),
+			MinServers:                          utils.Int32(minServers),
+			Path:                                utils.String(probePath),
+			Protocol:                            network.ApplicationGatewayProtocol(protocol),
+			Timeout:                             utils.Int32(timeout),
+			UnhealthyThreshold:                  utils.Int32(unhealthyThreshold),
+			PickHostNameFromBackendHTTPSettings: utils.Bool(pickHostNameFromBackendHTTPSettings),
+		},
+	}
 
-		matchConfigs := v["match"].([]interface{})
-		if len(matchConfigs) > 0 {
-			matchBody := ""
-			outputMatch := &network.ApplicationGatewayProbeHealthResponseMatch{}
-			if matchConfigs[0] != nil {
-				match := matchConfigs[0].(map[string]interface{})
-				matchBody = match["body"].(string)
+	matchConfigs := v["match"].([]interface{})
+	if len(matchConfigs) > 0 {
+		matchBody := ""
+		outputMatch := &network.ApplicationGatewayProbeHealthResponseMatch{}
+		if matchConfigs[0] != nil {
+			match := matchConfigs[0].(map[string]interface{})
+			matchBody = match["body"].(string)
 
-				statusCodes := make([]string, 0)
-				for _, statusCode := range match["status_code"].([]interface{}) {
-					statusCodes = append(statusCodes, statusCode.(string))
-				}
-				outputMatch.StatusCodes = &statusCodes
+			statusCodes := make([]string, 0)
+			for _, statusCode := range match["status_code"].([]interface{}) {
+				statusCodes = append(statusCodes, statusCode.(string))
 			}
-			outputMatch.Body = utils.String(matchBody)
-			output.ApplicationGatewayProbePropertiesFormat.Match = outputMatch
+			outputMatch.StatusCodes = &statusCodes
 		}
+		outputMatch.Body = utils.String(matchBody)
+		output.ApplicationGatewayProbePropertiesFormat.Match = outputMatch
+	}
 
-		if port != 0 {
-			output.ApplicationGatewayProbePropertiesFormat.Port = utils.Int32(port)
-		}
+	if port != 0 {
+		output.ApplicationGatewayProbePropertiesFormat.Port = utils.Int32(port)
+	}
 
-		results = append(results, output)
+	return output
+}
+
+func checkApplicationGatewayProbe(probe network.ApplicationGatewayProbe) error {
+	props := probe.ApplicationGatewayProbePropertiesFormat
+	if props == nil || props.Host == nil || props.PickHostNameFromBackendHTTPSettings == nil {
+		return nil
 	}
 
-	return &results
+	if *props.Host == "" && !*props.PickHostNameFromBackendHTTPSettings {
+		return fmt.Errorf("One of `host` or `pick_host_name_from_backend_http_settings` must be set")
+	}
+
+	if *props.Host != "" && *props.PickHostNameFromBackendHTTPSettings {
+		return fmt.Errorf("Only one of `host` or `pick_host_name_from_backend_http_settings` can be set")
+	}
+
+	return nil
 }
 
 func flattenApplicationGatewayProbes(input *[]network.ApplicationGatewayProbe) []interface{} {
@@ -2795,165 +2869,189 @@ func flattenApplicationGatewayProbes(input *[]network.ApplicationGatewayProbe) [
 	}
 
 	for _, v := range *input {
-		output := map[string]interface{}{}
+		results = append(results, flattenApplicationGatewayProbe(v))
+	}
 
-		if v.ID != nil {
-			output["id"] = *v.ID
-		}
+	return results
+}
 
-		if v.Name != nil {
-			output["name"] = *v.Name
-		}
+func flattenApplicationGatewayProbe(v network.ApplicationGatewayProbe) map[string]interface{} {
+	output := map[string]interface{}{}
 
-		if props := v.ApplicationGatewayProbePropertiesFormat; props != nil {
-			output["protocol"] = string(props.Protocol)
+	if v.ID != nil {
+		output["id"] = *v.ID
+	}
 
-			if host := props.Host; host != nil {
-				output["host"] = *host
-			}
+	if v.Name != nil {
+		output["name"] = *v.Name
+	}
 
-			if path := props.Path; path != nil {
-				output["path"] = *path
-			}
+	if props := v.ApplicationGatewayProbePropertiesFormat; props != nil {
+		output["protocol"] = string(props.Protocol)
 
-			if interval := props.Interval; interval != nil {
-				output["interval"] = int(*interval)
-			}
+		if host := props.Host; host != nil {
+			output["host"] = *host
+		}
 
-			if timeout := props.Timeout; timeout != nil {
-				output["timeout"] = int(*timeout)
-			}
+		if path := props.Path; path != nil {
+			output["path"] = *path
+		}
 
-			if threshold := props.UnhealthyThreshold; threshold != nil {
-				output["unhealthy_threshold"] = int(*threshold)
-			}
+		if interval := props.Interval; interval != nil {
+			output["interval"] = int(*interval)
+		}
 
-			port := 0
-			if props.Port != nil {
-				port = int(*props.Port)
-			}
-			output["port"] = port
+		if timeout := props.Timeout; timeout != nil {
+			output["timeout"] = int(*timeout)
+		}
 
-			if pickHostNameFromBackendHTTPSettings := props.PickHostNameFromBackendHTTPSettings; pickHostNameFromBackendHTTPSettings != nil {
-				output["pick_host_name_from_backend_http_settings"] = *pickHostNameFromBackendHTTPSettings
-			}
+		if threshold := props.UnhealthyThreshold; threshold != nil {
+			output["unhealthy_threshold"] = int(*threshold)
+		}
 
-			if minServers := props.MinServers; minServers != nil {
-				output["minimum_servers"] = int(*minServers)
-			}
+		port := 0
+		if props.Port != nil {
+			port = int(*props.Port)
+		}
+		output["port"] = port
 
-			matches := make([]interface{}, 0)
-			if match := props.Match; match != nil {
-				matchConfig := map[string]interface{}{}
-				if body := match.Body; body != nil {
-					matchConfig["body"] = *body
-				}
+		if pickHostNameFromBackendHTTPSettings := props.PickHostNameFromBackendHTTPSettings; pickHostNameFromBackendHTTPSettings != nil {
+			output["pick_host_name_from_backend_http_settings"] = *pickHostNameFromBackendHTTPSettings
+		}
 
-				statusCodes := make([]interface{}, 0)
-				if match.StatusCodes != nil {
-					for _, status := range *match.StatusCodes {
-						statusCodes = append(statusCodes, status)
-					}
+		if minServers := props.MinServers; minServers != nil {
+			output["minimum_servers"] = int(*minServers)
+		}
+
+		matches := make([]interface{}, 0)
+		if match := props.Match; match != nil {
+			matchConfig := map[string]interface{}{}
+			if body := match.Body; body != nil {
+				matchConfig["body"] = *body
+			}
+
+			statusCodes := make([]interface{}, 0)
+			if match.StatusCodes != nil {
+				for _, status := range *match.StatusCodes {
+					statusCodes = append(statusCodes, status)
 				}
-				matchConfig["status_code"] = statusCodes
-				matches = append(matches, matchConfig)
 			}
-			output["match"] = matches
+			matchConfig["status_code"] = statusCodes
+			matches = append(matches, matchConfig)
 		}
-
-		results = append(results, output)
+		output["match"] = matches
 	}
 
-	return results
+	return output
 }
 
 func expandApplicationGatewayRequestRoutingRules(d *pluginsdk.ResourceData, gatewayID string) (*[]network.ApplicationGatewayRequestRoutingRule, error) {
 	vs := d.Get("request_routing_rule").(*pluginsdk.Set).List()
 	results := make([]network.ApplicationGatewayRequestRoutingRule, 0)
-	priorityset := false
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
-
-		name := v["name"].(string)
-		ruleType := v["rule_type"].(string)
-		httpListenerName := v["http_listener_name"].(string)
-		httpListenerID := fmt.Sprintf("%s/httpListeners/%s", gatewayID, httpListenerName)
-		backendAddressPoolName := v["backend_address_pool_name"].(string)
-		backendHTTPSettingsName := v["backend_http_settings_name"].(string)
-		redirectConfigName := v["redirect_configuration_name"].(string)
-		priority := int32(v["priority"].(int))
-
-		rule := network.ApplicationGatewayRequestRoutingRule{
-			Name: utils.String(name),
-			ApplicationGatewayRequestRoutingRulePropertiesFormat: &network.ApplicationGatewayRequestRoutingRulePropertiesFormat{
-				RuleType: network.ApplicationGatewayRequestRoutingRuleType(ruleType),
-				HTTPListener: &network.SubResource{
-					ID: utils.String(httpListenerID),
-				},
-			},
+		rule, err := expandApplicationGatewayRequestRoutingRule(raw.(map[string]interface{}), gatewayID)
+		if err != nil {
+			return nil, err
 		}
 
-		if backendAddressPoolName != "" && redirectConfigName != "" {
-			return nil, fmt.Errorf("Conflict between `backend_address_pool_name` and `redirect_configuration_name` (back-end pool not applicable when redirection specified)")
-		}
+		results = append(results, *rule)
+	}
 
-		if backendHTTPSettingsName != "" && redirectConfigName != "" {
-			return nil, fmt.Errorf("Conflict between `backend_http_settings_name` and `redirect_configuration_name` (back-end settings not applicable when redirection specified)")
-		}
+	if err := checkApplicationGatewayRequestRoutingRulePriorities(results); err != nil {
+		return nil, err
+	}
 
-		if backendAddressPoolName != "" {
-			backendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, backendAddressPoolName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendAddressPool = &network.SubResource{
-				ID: utils.String(backendAddressPoolID),
-			}
-		}
+	return &results, nil
+}
 
-		if backendHTTPSettingsName != "" {
-			backendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, backendHTTPSettingsName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendHTTPSettings = &network.SubResource{
-				ID: utils.String(backendHTTPSettingsID),
-			}
+func checkApplicationGatewayRequestRoutingRulePriorities(input []network.ApplicationGatewayRequestRoutingRule) error {
+	priorityset := false
+	for _, rule := range input {
+		if props := rule.ApplicationGatewayRequestRoutingRulePropertiesFormat; props != nil && props.Priority != nil {
+			priorityset = true
 		}
+	}
 
-		if redirectConfigName != "" {
-			redirectConfigID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, redirectConfigName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RedirectConfiguration = &network.SubResource{
-				ID: utils.String(redirectConfigID),
+	if priorityset {
+		for _, rule := range input {
+			if props := rule.ApplicationGatewayRequestRoutingRulePropertiesFormat; props == nil || props.Priority == nil {
+				return fmt.Errorf("If you wish to use rule priority, you will have to specify rule-priority field values for all the existing request routing rules.")
 			}
 		}
+	}
 
-		if urlPathMapName := v["url_path_map_name"].(string); urlPathMapName != "" {
-			urlPathMapID := fmt.Sprintf("%s/urlPathMaps/%s", gatewayID, urlPathMapName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.URLPathMap = &network.SubResource{
-				ID: utils.String(urlPathMapID),
-			}
+	return nil
+}
+
+func expandApplicationGatewayRequestRoutingRule(v map[string]interface{}, gatewayID string) (*network.ApplicationGatewayRequestRoutingRule, error) {
+	name := v["name"].(string)
+	ruleType := v["rule_type"].(string)
+	httpListenerName := v["http_listener_name"].(string)
+	httpListenerID := fmt.Sprintf("%s/httpListeners/%s", gatewayID, httpListenerName)
+	backendAddressPoolName := v["backend_address_pool_name"].(string)
+	backendHTTPSettingsName := v["backend_http_settings_name"].(string)
+	redirectConfigName := v["redirect_configuration_name"].(string)
+	priority := int32(v["priority"].(int))
+
+	rule := network.ApplicationGatewayRequestRoutingRule{
+		Name: utils.String(name),
+		ApplicationGatewayRequestRoutingRulePropertiesFormat: &network.ApplicationGatewayRequestRoutingRulePropertiesFormat{
+			RuleType: network.ApplicationGatewayRequestRoutingRuleType(ruleType),
+			HTTPListener: &network.SubResource{
+				ID: utils.String(httpListenerID),
+			},
+		},
+	}
+
+	if backendAddressPoolName != "" && redirectConfigName != "" {
+		return nil, fmt.Errorf("Conflict between `backend_address_pool_name` and `redirect_configuration_name` (back-end pool not applicable when redirection specified)")
+	}
+
+	if backendHTTPSettingsName != "" && redirectConfigName != "" {
+		return nil, fmt.Errorf("Conflict between `backend_http_settings_name` and `redirect_configuration_name` (back-end settings not applicable when redirection specified)")
+	}
+
+	if backendAddressPoolName != "" {
+		backendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, backendAddressPoolName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendAddressPool = &network.SubResource{
+			ID: utils.String(backendAddressPoolID),
 		}
+	}
 
-		if rewriteRuleSetName := v["rewrite_rule_set_name"].(string); rewriteRuleSetName != "" {
-			rewriteRuleSetID := fmt.Sprintf("%s/rewriteRuleSets/%s", gatewayID, rewriteRuleSetName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RewriteRuleSet = &network.SubResource{
-				ID: utils.String(rewriteRuleSetID),
-			}
+	if backendHTTPSettingsName != "" {
+		backendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, backendHTTPSettingsName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendHTTPSettings = &network.SubResource{
+			ID: utils.String(backendHTTPSettingsID),
 		}
+	}
 
-		if priority != 0 {
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.Priority = &priority
-			priorityset = true
+	if redirectConfigName != "" {
+		redirectConfigID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, redirectConfigName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RedirectConfiguration = &network.SubResource{
+			ID: utils.String(redirectConfigID),
 		}
+	}
 
-		results = append(results, rule)
+	if urlPathMapName := v["url_path_map_name"].(string); urlPathMapName != "" {
+		urlPathMapID := fmt.Sprintf("%s/urlPathMaps/%s", gatewayID, urlPathMapName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.URLPathMap = &network.SubResource{
+			ID: utils.String(urlPathMapID),
+		}
 	}
 
-	if priorityset {
-		for _, rule := range results {
-			if rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.Priority == nil {
-				return nil, fmt.Errorf("If you wish to use rule priority, you will have to specify rule-priority field values for all the existing request routing rules.")
-			}
+	if rewriteRuleSetName := v["rewrite_rule_set_name"].(string); rewriteRuleSetName != "" {
+		rewriteRuleSetID := fmt.Sprintf("%s/rewriteRuleSets/%s", gatewayID, rewriteRuleSetName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RewriteRuleSet = &network.SubResource{
+			ID: utils.String(rewriteRuleSetID),
 		}
 	}
 
-	return &results, nil
+	if priority != 0 {
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.Priority = &priority
+	}
+
+	return &rule, nil
 }
 
 func flattenApplicationGatewayRequestRoutingRules(input *[]network.ApplicationGatewayRequestRoutingRule) ([]interface{}, error) {
@@ -2963,95 +3061,111 @@ func flattenApplicationGatewayRequestRoutingRules(input *[]network.ApplicationGa
 	}
 
 	for _, config := range *input {
-		if props := config.ApplicationGatewayRequestRoutingRulePropertiesFormat; props != nil {
-			output := map[string]interface{}{
-				"rule_type": string(props.RuleType),
-			}
+		if config.ApplicationGatewayRequestRoutingRulePropertiesFormat == nil {
+			continue
+		}
 
-			if config.ID != nil {
-				output["id"] = *config.ID
-			}
+		output, err := flattenApplicationGatewayRequestRoutingRule(config)
+		if err != nil {
+			return nil, err
+		}
 
-			if config.Name != nil {
-				output["name"] = *config.Name
-			}
+		results = append(results, output)
+	}
 
-			if config.Priority != nil {
-				output["priority"] = *config.Priority
-			}
+	return results, nil
+}
 
-			if pool := props.BackendAddressPool; pool != nil {
-				if pool.ID != nil {
-					poolId, err := parse.BackendAddressPoolID(*pool.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["backend_address_pool_name"] = poolId.Name
-					output["backend_address_pool_id"] = poolId.ID()
-				}
-			}
+func flattenApplicationGatewayRequestRoutingRule(config network.ApplicationGatewayRequestRoutingRule) (map[string]interface{}, error) {
+	props := config.ApplicationGatewayRequestRoutingRulePropertiesFormat
+	if props == nil {
+		return nil, fmt.Errorf("`properties` was nil")
+	}
 
-			if settings := props.BackendHTTPSettings; settings != nil {
-				if settings.ID != nil {
-					settingsId, err := parse.BackendHttpSettingsCollectionID(*settings.ID)
-					if err != nil {
-						return nil, err
-					}
+	output := map[string]interface{}{
+		"rule_type": string(props.RuleType),
+	}
 
-					output["backend_http_settings_name"] = settingsId.BackendHttpSettingsCollectionName
-					output["backend_http_settings_id"] = *settings.ID
-				}
+	if config.ID != nil {
+		output["id"] = *config.ID
+	}
+
+	if config.Name != nil {
+		output["name"] = *config.Name
+	}
+
+	if config.Priority != nil {
+		output["priority"] = *config.Priority
+	}
+
+	if pool := props.BackendAddressPool; pool != nil {
+		if pool.ID != nil {
+			poolId, err := parse.BackendAddressPoolID(*pool.ID)
+			if err != nil {
+				return nil, err
 			}
+			output["backend_address_pool_name"] = poolId.Name
+			output["backend_address_pool_id"] = poolId.ID()
+		}
+	}
 
-			if listener := props.HTTPListener; listener != nil {
-				if listener.ID != nil {
-					listenerId, err := parse.HttpListenerID(*listener.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["http_listener_id"] = listenerId.ID()
-					output["http_listener_name"] = listenerId.Name
-				}
+	if settings := props.BackendHTTPSettings; settings != nil {
+		if settings.ID != nil {
+			settingsId, err := parse.BackendHttpSettingsCollectionID(*settings.ID)
+			if err != nil {
+				return nil, err
 			}
 
-			if pathMap := props.URLPathMap; pathMap != nil {
-				if pathMap.ID != nil {
-					pathMapId, err := parse.UrlPathMapID(*pathMap.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["url_path_map_name"] = pathMapId.Name
-					output["url_path_map_id"] = pathMapId.ID()
-				}
+			output["backend_http_settings_name"] = settingsId.BackendHttpSettingsCollectionName
+			output["backend_http_settings_id"] = *settings.ID
+		}
+	}
+
+	if listener := props.HTTPListener; listener != nil {
+		if listener.ID != nil {
+			listenerId, err := parse.HttpListenerID(*listener.ID)
+			if err != nil {
+				return nil, err
 			}
+			output["http_listener_id"] = listenerId.ID()
+			output["http_listener_name"] = listenerId.Name
+		}
+	}
 
-			if redirect := props.RedirectConfiguration; redirect != nil {
-				if redirect.ID != nil {
-					redirectId, err := parse.RedirectConfigurationsID(*redirect.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["redirect_configuration_name"] = redirectId.RedirectConfigurationName
-					output["redirect_configuration_id"] = redirectId.ID()
-				}
+	if pathMap := props.URLPathMap; pathMap != nil {
+		if pathMap.ID != nil {
+			pathMapId, err := parse.UrlPathMapID(*pathMap.ID)
+			if err != nil {
+				return nil, err
 			}
+			output["url_path_map_name"] = pathMapId.Name
+			output["url_path_map_id"] = pathMapId.ID()
+		}
+	}
 
-			if rewrite := props.RewriteRuleSet; rewrite != nil {
-				if rewrite.ID != nil {
-					rewriteId, err := parse.RewriteRuleSetID(*rewrite.ID)
-					if err != nil {
-						return nil, err
-					}
-					output["rewrite_rule_set_name"] = rewriteId.Name
-					output["rewrite_rule_set_id"] = rewriteId.ID()
-				}
+	if redirect := props.RedirectConfiguration; redirect != nil {
+		if redirect.ID != nil {
+			redirectId, err := parse.RedirectConfigurationsID(*redirect.ID)
+			if err != nil {
+				return nil, err
 			}
+			output["redirect_configuration_name"] = redirectId.RedirectConfigurationName
+			output["redirect_configuration_id"] = redirectId.ID()
+		}
+	}
 
-			results = append(results, output)
+	if rewrite := props.RewriteRuleSet; rewrite != nil {
+		if rewrite.ID != nil {
+			rewriteId, err := parse.RewriteRuleSetID(*rewrite.ID)
+			if err != nil {
+				return nil, err
+			}
+			output["rewrite_rule_set_name"] = rewriteId.Name
+			output["rewrite_rule_set_id"] = rewriteId.ID()
 		}
 	}
 
-	return results, nil
+	return output, nil
 }
 
 func expandApplicationGatewayRewriteRuleSets(d *pluginsdk.ResourceData) (*[]network.ApplicationGatewayRewriteRuleSet, error) {
@@ -3447,43 +3561,50 @@ func expandApplicationGatewaySslCertificates(d *pluginsdk.ResourceData) (*[]netw
 	results := make([]network.ApplicationGatewaySslCertificate, 0)
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
+		certificate, err := expandApplicationGatewaySslCertificate(raw.(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
 
-		name := v["name"].(string)
-		data := v["data"].(string)
-		password := v["password"].(string)
-		kvsid := v["key_vault_secret_id"].(string)
-		cert := v["public_cert_data"].(string)
+		results = append(results, *certificate)
+	}
 
-		output := network.ApplicationGatewaySslCertificate{
-			Name: utils.String(name),
-			ApplicationGatewaySslCertificatePropertiesFormat: &network.ApplicationGatewaySslCertificatePropertiesFormat{},
-		}
+	return &results, nil
+}
 
-		// nolint gocritic
-		if data != "" && kvsid != "" {
-			return nil, fmt.Errorf("only one of `key_vault_secret_id` or `data` must be specified for the `ssl_certificate` block %q", name)
-		} else if data != "" {
-			// data must be base64 encoded
-			output.ApplicationGatewaySslCertificatePropertiesFormat.Data = utils.String(utils.Base64EncodeIfNot(data))
+func expandApplicationGatewaySslCertificate(v map[string]interface{}) (*network.ApplicationGatewaySslCertificate, error) {
+	name := v["name"].(string)
+	data := v["data"].(string)
+	password := v["password"].(string)
+	kvsid := v["key_vault_secret_id"].(string)
+	cert := v["public_cert_data"].(string)
 
-			output.ApplicationGatewaySslCertificatePropertiesFormat.Password = utils.String(password)
-		} else if kvsid != "" {
-			if password != "" {
-				return nil, fmt.Errorf("only one of `key_vault_secret_id` or `password` must be specified for the `ssl_certificate` block %q", name)
-			}
+	output := network.ApplicationGatewaySslCertificate{
+		Name: utils.String(name),
+		ApplicationGatewaySslCertificatePropertiesFormat: &network.ApplicationGatewaySslCertificatePropertiesFormat{},
+	}
 
-			output.ApplicationGatewaySslCertificatePropertiesFormat.KeyVaultSecretID = utils.String(kvsid)
-		} else if cert != "" {
-			output.ApplicationGatewaySslCertificatePropertiesFormat.PublicCertData = utils.String(cert)
-		} else {
-			return nil, fmt.Errorf("either `key_vault_secret_id` or `data` must be specified for the `ssl_certificate` block %q", name)
+	// nolint gocritic
+	if data != "" && kvsid != "" {
+		return nil, fmt.Errorf("only one of `key_vault_secret_id` or `data` must be specified for the `ssl_certificate` block %q", name)
+	} else if data != "" {
+		// data must be base64 encoded
+		output.ApplicationGatewaySslCertificatePropertiesFormat.Data = utils.String(utils.Base64EncodeIfNot(data))
+
+		output.ApplicationGatewaySslCertificatePropertiesFormat.Password = utils.String(password)
+	} else if kvsid != "" {
+		if password != "" {
+			return nil, fmt.Errorf("only one of `key_vault_secret_id` or `password` must be specified for the `ssl_certificate` block %q", name)
 		}
 
-		results = append(results, output)
+		output.ApplicationGatewaySslCertificatePropertiesFormat.KeyVaultSecretID = utils.String(kvsid)
+	} else if cert != "" {
+		output.ApplicationGatewaySslCertificatePropertiesFormat.PublicCertData = utils.String(cert)
+	} else {
+		return nil, fmt.Errorf("either `key_vault_secret_id` or `data` must be specified for the `ssl_certificate` block %q", name)
 	}
 
-	return &results, nil
+	return &output, nil
 }
 
 func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewaySslCertificate, d *pluginsdk.ResourceData) []interface{} {
@@ -3493,28 +3614,12 @@ func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewa
 	}
 
 	for _, v := range *input {
-		output := map[string]interface{}{}
 		if v.Name == nil {
 			continue
 		}
 
 		name := *v.Name
-
-		if v.ID != nil {
-			output["id"] = *v.ID
-		}
-
-		output["name"] = name
-
-		if props := v.ApplicationGatewaySslCertificatePropertiesFormat; props != nil {
-			if data := props.PublicCertData; data != nil {
-				output["public_cert_data"] = *data
-			}
-
-			if kvsid := props.KeyVaultSecretID; kvsid != nil {
-				output["key_vault_secret_id"] = *kvsid
-			}
-		}
+		output := flattenApplicationGatewaySslCertificate(v)
 
 		// since the certificate data isn't returned we have to load it from the same index
 		if existing, ok := d.GetOk("ssl_certificate"); ok && existing != nil {
@@ -3542,6 +3647,30 @@ func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewa
 	return results
 }
 
+func flattenApplicationGatewaySslCertificate(v network.ApplicationGatewaySslCertificate) map[string]interface{} {
+	output := map[string]interface{}{}
+
+	if v.ID != nil {
+		output["id"] = *v.ID
+	}
+
+	if v.Name != nil {
+		output["name"] = *v.Name
+	}
+
+	if props := v.ApplicationGatewaySslCertificatePropertiesFormat; props != nil {
+		if data := props.PublicCertData; data != nil {
+			output["public_cert_data"] = *data
+		}
+
+		if kvsid := props.KeyVaultSecretID; kvsid != nil {
+			output["key_vault_secret_id"] = *kvsid
+		}
+	}
+
+	return output
+}
+
 func expandApplicationGatewayTrustedClientCertificates(d *pluginsdk.ResourceData) (*[]network.ApplicationGatewayTrustedClientCertificate, error) {
 	vs := d.Get("trusted_client_certificate").([]interface{})
 	results := make([]network.ApplicationGatewayTrustedClientCertificate, 0)
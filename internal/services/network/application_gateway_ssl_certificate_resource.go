@@ -0,0 +1,165 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApplicationGatewaySslCertificate() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewaySslCertificateCreateUpdate,
+		Read:   resourceApplicationGatewaySslCertificateRead,
+		Update: resourceApplicationGatewaySslCertificateCreateUpdate,
+		Delete: resourceApplicationGatewaySslCertificateDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.SslCertificateID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewayChildResourceSchema(applicationGatewaySslCertificateSchema()),
+	}
+}
+
+func resourceApplicationGatewaySslCertificateCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewSslCertificateID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+
+	certificate, err := expandApplicationGatewaySslCertificate(expandApplicationGatewayChildResource(d, applicationGatewaySslCertificateSchema()))
+	if err != nil {
+		return fmt.Errorf("expanding %s: %+v", id, err)
+	}
+
+	err = updateApplicationGatewayChildResource(ctx, client, *gatewayId, false, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		certificates := make([]network.ApplicationGatewaySslCertificate, 0)
+		if props.SslCertificates != nil {
+			certificates = *props.SslCertificates
+		}
+
+		exists := false
+		for i, v := range certificates {
+			if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+				if d.IsNewResource() {
+					return tf.ImportAsExistsError("azurerm_application_gateway_ssl_certificate", id.ID())
+				}
+
+				certificates[i] = *certificate
+				exists = true
+			}
+		}
+		if !exists {
+			certificates = append(certificates, *certificate)
+		}
+
+		props.SslCertificates = &certificates
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+	return resourceApplicationGatewaySslCertificateRead(d, meta)
+}
+
+func resourceApplicationGatewaySslCertificateRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SslCertificateID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", gatewayId, *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var certificate *network.ApplicationGatewaySslCertificate
+	if props := gateway.ApplicationGatewayPropertiesFormat; props != nil && props.SslCertificates != nil {
+		for _, v := range *props.SslCertificates {
+			if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+				v := v
+				certificate = &v
+				break
+			}
+		}
+	}
+	if certificate == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.Name)
+	d.Set("application_gateway_id", gatewayId.ID())
+
+	output := flattenApplicationGatewaySslCertificate(*certificate)
+
+	// since the certificate data and password aren't returned these are loaded from the existing state
+	output["data"] = d.Get("data").(string)
+	output["password"] = d.Get("password").(string)
+
+	return setApplicationGatewayChildResource(d, output, applicationGatewaySslCertificateSchema())
+}
+
+func resourceApplicationGatewaySslCertificateDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SslCertificateID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	return updateApplicationGatewayChildResource(ctx, client, gatewayId, true, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		certificates := make([]network.ApplicationGatewaySslCertificate, 0)
+		if props.SslCertificates != nil {
+			for _, v := range *props.SslCertificates {
+				if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+					continue
+				}
+
+				certificates = append(certificates, v)
+			}
+		}
+
+		props.SslCertificates = &certificates
+		return nil
+	})
+}
@@ -0,0 +1,95 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationGatewaySslCertificateResource struct{}
+
+func TestAccApplicationGatewaySslCertificate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_ssl_certificate", "test")
+	r := ApplicationGatewaySslCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("data", "password"),
+	})
+}
+
+func TestAccApplicationGatewaySslCertificate_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_ssl_certificate", "test")
+	r := ApplicationGatewaySslCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (t ApplicationGatewaySslCertificateResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SslCertificateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.SslCertificates != nil {
+		for _, v := range *props.SslCertificates {
+			if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r ApplicationGatewaySslCertificateResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_ssl_certificate" "test" {
+  name                   = "acctest-sslcert-%d"
+  application_gateway_id = azurerm_application_gateway.test.id
+  data                   = filebase64("testdata/application_gateway_test.pfx")
+  password               = "terraform"
+}
+`, ApplicationGatewayResource{}.ignoreChildManagedBlocks(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewaySslCertificateResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_ssl_certificate" "import" {
+  name                   = azurerm_application_gateway_ssl_certificate.test.name
+  application_gateway_id = azurerm_application_gateway_ssl_certificate.test.application_gateway_id
+  data                   = azurerm_application_gateway_ssl_certificate.test.data
+  password               = azurerm_application_gateway_ssl_certificate.test.password
+}
+`, r.basic(data))
+}
@@ -2,7 +2,16 @@ package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	network20210501 "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/adminrulecollections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/adminrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/connectivityconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/networkgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/networkmanagercommits"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/networkmanagerdeploymentstatus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/securityadminconfigurations"
 )
 
 type Client struct {
@@ -45,11 +54,13 @@ type Client struct {
 	VirtualHubIPClient                     *network.VirtualHubIPConfigurationClient
 	VnetGatewayConnectionsClient           *network.VirtualNetworkGatewayConnectionsClient
 	VnetGatewayClient                      *network.VirtualNetworkGatewaysClient
+	VnetGatewayNatRuleClient               *network20210501.VirtualNetworkGatewayNatRulesClient
 	VnetClient                             *network.VirtualNetworksClient
 	VnetPeeringsClient                     *network.VirtualNetworkPeeringsClient
 	VirtualWanClient                       *network.VirtualWansClient
 	VirtualHubClient                       *network.VirtualHubsClient
 	VpnConnectionsClient                   *network.VpnConnectionsClient
+	VpnGatewayNatRuleClient                *network20210501.NatRulesClient
 	VpnGatewaysClient                      *network.VpnGatewaysClient
 	VpnServerConfigurationsClient          *network.VpnServerConfigurationsClient
 	VpnSitesClient                         *network.VpnSitesClient
@@ -59,6 +70,15 @@ type Client struct {
 	PrivateLinkServiceClient               *network.PrivateLinkServicesClient
 	ServiceAssociationLinkClient           *network.ServiceAssociationLinksClient
 	ResourceNavigationLinkClient           *network.ResourceNavigationLinksClient
+
+	ManagerAdminRuleCollectionsClient        *adminrulecollections.AdminRuleCollectionsClient
+	ManagerAdminRulesClient                  *adminrules.AdminRulesClient
+	ManagerConnectivityConfigurationsClient  *connectivityconfigurations.ConnectivityConfigurationsClient
+	ManagerNetworkGroupsClient               *networkgroups.NetworkGroupsClient
+	ManagerCommitsClient                     *networkmanagercommits.NetworkManagerCommitsClient
+	ManagerDeploymentStatusClient            *networkmanagerdeploymentstatus.NetworkManagerDeploymentStatusClient
+	ManagersClient                           *networkmanagers.NetworkManagersClient
+	ManagerSecurityAdminConfigurationsClient *securityadminconfigurations.SecurityAdminConfigurationsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -188,6 +208,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	VnetGatewayClient := network.NewVirtualNetworkGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VnetGatewayClient.Client, o.ResourceManagerAuthorizer)
 
+	VnetGatewayNatRuleClient := network20210501.NewVirtualNetworkGatewayNatRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VnetGatewayNatRuleClient.Client, o.ResourceManagerAuthorizer)
+
 	NatGatewayClient := network.NewNatGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&NatGatewayClient.Client, o.ResourceManagerAuthorizer)
 
@@ -203,6 +226,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	vpnGatewaysClient := network.NewVpnGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vpnGatewaysClient.Client, o.ResourceManagerAuthorizer)
 
+	vpnGatewayNatRuleClient := network20210501.NewNatRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vpnGatewayNatRuleClient.Client, o.ResourceManagerAuthorizer)
+
 	vpnConnectionsClient := network.NewVpnConnectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vpnConnectionsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -221,6 +247,30 @@ func NewClient(o *common.ClientOptions) *Client {
 	ResourceNavigationLinkClient := network.NewResourceNavigationLinksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ResourceNavigationLinkClient.Client, o.ResourceManagerAuthorizer)
 
+	managerAdminRuleCollectionsClient := adminrulecollections.NewAdminRuleCollectionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managerAdminRuleCollectionsClient.Client, o.ResourceManagerAuthorizer)
+
+	managerAdminRulesClient := adminrules.NewAdminRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managerAdminRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	managerConnectivityConfigurationsClient := connectivityconfigurations.NewConnectivityConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managerConnectivityConfigurationsClient.Client, o.ResourceManagerAuthorizer)
+
+	managerNetworkGroupsClient := networkgroups.NewNetworkGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managerNetworkGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	managerCommitsClient := networkmanagercommits.NewNetworkManagerCommitsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managerCommitsClient.Client, o.ResourceManagerAuthorizer)
+
+	managerDeploymentStatusClient := networkmanagerdeploymentstatus.NewNetworkManagerDeploymentStatusClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managerDeploymentStatusClient.Client, o.ResourceManagerAuthorizer)
+
+	managersClient := networkmanagers.NewNetworkManagersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managersClient.Client, o.ResourceManagerAuthorizer)
+
+	managerSecurityAdminConfigurationsClient := securityadminconfigurations.NewSecurityAdminConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&managerSecurityAdminConfigurationsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		ApplicationGatewaysClient:              &ApplicationGatewaysClient,
 		ApplicationSecurityGroupsClient:        &ApplicationSecurityGroupsClient,
@@ -261,11 +311,13 @@ func NewClient(o *common.ClientOptions) *Client {
 		VirtualHubIPClient:                     &VirtualHubIPClient,
 		VnetGatewayConnectionsClient:           &VnetGatewayConnectionsClient,
 		VnetGatewayClient:                      &VnetGatewayClient,
+		VnetGatewayNatRuleClient:               &VnetGatewayNatRuleClient,
 		VnetClient:                             &VnetClient,
 		VnetPeeringsClient:                     &VnetPeeringsClient,
 		VirtualWanClient:                       &VirtualWanClient,
 		VirtualHubClient:                       &VirtualHubClient,
 		VpnConnectionsClient:                   &vpnConnectionsClient,
+		VpnGatewayNatRuleClient:                &vpnGatewayNatRuleClient,
 		VpnGatewaysClient:                      &vpnGatewaysClient,
 		VpnServerConfigurationsClient:          &vpnServerConfigurationsClient,
 		VpnSitesClient:                         &vpnSitesClient,
@@ -275,5 +327,14 @@ func NewClient(o *common.ClientOptions) *Client {
 		PrivateLinkServiceClient:               &PrivateLinkServiceClient,
 		ServiceAssociationLinkClient:           &ServiceAssociationLinkClient,
 		ResourceNavigationLinkClient:           &ResourceNavigationLinkClient,
+
+		ManagerAdminRuleCollectionsClient:        &managerAdminRuleCollectionsClient,
+		ManagerAdminRulesClient:                  &managerAdminRulesClient,
+		ManagerConnectivityConfigurationsClient:  &managerConnectivityConfigurationsClient,
+		ManagerNetworkGroupsClient:               &managerNetworkGroupsClient,
+		ManagerCommitsClient:                     &managerCommitsClient,
+		ManagerDeploymentStatusClient:            &managerDeploymentStatusClient,
+		ManagersClient:                           &managersClient,
+		ManagerSecurityAdminConfigurationsClient: &managerSecurityAdminConfigurationsClient,
 	}
 }
@@ -0,0 +1,146 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceNetworkInterfaceEffectiveRoutes() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkInterfaceEffectiveRoutesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"network_interface_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NetworkInterfaceID,
+			},
+
+			"route": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"source": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"address_prefixes": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"next_hop_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"next_hop_ip_addresses": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"bgp_route_propagation_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkInterfaceEffectiveRoutesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceID(d.Get("network_interface_id").(string))
+	if err != nil {
+		return err
+	}
+
+	future, err := client.GetEffectiveRouteTable(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving the Effective Routes for %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting to retrieve the Effective Routes for %s: %+v", *id, err)
+	}
+
+	resp, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving the Effective Routes for %s: %+v", *id, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/effectiveRouteTable", id.ID()))
+
+	d.Set("network_interface_id", id.ID())
+	if err := d.Set("route", flattenNetworkInterfaceEffectiveRoutes(resp.Value)); err != nil {
+		return fmt.Errorf("setting `route`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenNetworkInterfaceEffectiveRoutes(input *[]network.EffectiveRoute) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		bgpRoutePropagationEnabled := true
+		if item.DisableBgpRoutePropagation != nil {
+			bgpRoutePropagationEnabled = !*item.DisableBgpRoutePropagation
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                          name,
+			"source":                        string(item.Source),
+			"state":                         string(item.State),
+			"address_prefixes":              utils.FlattenStringSlice(item.AddressPrefix),
+			"next_hop_type":                 string(item.NextHopType),
+			"next_hop_ip_addresses":         utils.FlattenStringSlice(item.NextHopIPAddress),
+			"bgp_route_propagation_enabled": bgpRoutePropagationEnabled,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,249 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceNetworkInterfaceEffectiveSecurityRules() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkInterfaceEffectiveSecurityRulesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"network_interface_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NetworkInterfaceID,
+			},
+
+			"network_security_group": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"associated_network_interface_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"associated_subnet_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"security_rule": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"access": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"direction": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"priority": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"protocol": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"source_port_ranges": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"destination_port_ranges": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"source_address_prefixes": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"destination_address_prefixes": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"expanded_source_address_prefixes": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+
+									"expanded_destination_address_prefixes": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkInterfaceEffectiveSecurityRulesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceID(d.Get("network_interface_id").(string))
+	if err != nil {
+		return err
+	}
+
+	future, err := client.ListEffectiveNetworkSecurityGroups(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving the Effective Network Security Groups for %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting to retrieve the Effective Network Security Groups for %s: %+v", *id, err)
+	}
+
+	resp, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving the Effective Network Security Groups for %s: %+v", *id, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/effectiveNetworkSecurityGroups", id.ID()))
+
+	d.Set("network_interface_id", id.ID())
+	if err := d.Set("network_security_group", flattenNetworkInterfaceEffectiveNetworkSecurityGroups(resp.Value)); err != nil {
+		return fmt.Errorf("setting `network_security_group`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenNetworkInterfaceEffectiveNetworkSecurityGroups(input *[]network.EffectiveNetworkSecurityGroup) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		networkSecurityGroupId := ""
+		if item.NetworkSecurityGroup != nil && item.NetworkSecurityGroup.ID != nil {
+			networkSecurityGroupId = *item.NetworkSecurityGroup.ID
+		}
+
+		networkInterfaceId := ""
+		subnetId := ""
+		if association := item.Association; association != nil {
+			if association.NetworkInterface != nil && association.NetworkInterface.ID != nil {
+				networkInterfaceId = *association.NetworkInterface.ID
+			}
+			if association.Subnet != nil && association.Subnet.ID != nil {
+				subnetId = *association.Subnet.ID
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                              networkSecurityGroupId,
+			"associated_network_interface_id": networkInterfaceId,
+			"associated_subnet_id":            subnetId,
+			"security_rule":                   flattenNetworkInterfaceEffectiveSecurityRules(item.EffectiveSecurityRules),
+		})
+	}
+
+	return results
+}
+
+func flattenNetworkInterfaceEffectiveSecurityRules(input *[]network.EffectiveNetworkSecurityRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		priority := 0
+		if item.Priority != nil {
+			priority = int(*item.Priority)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                                  name,
+			"access":                                string(item.Access),
+			"direction":                             string(item.Direction),
+			"priority":                              priority,
+			"protocol":                              string(item.Protocol),
+			"source_port_ranges":                    flattenEffectiveSecurityRuleValues(item.SourcePortRange, item.SourcePortRanges),
+			"destination_port_ranges":               flattenEffectiveSecurityRuleValues(item.DestinationPortRange, item.DestinationPortRanges),
+			"source_address_prefixes":               flattenEffectiveSecurityRuleValues(item.SourceAddressPrefix, item.SourceAddressPrefixes),
+			"destination_address_prefixes":          flattenEffectiveSecurityRuleValues(item.DestinationAddressPrefix, item.DestinationAddressPrefixes),
+			"expanded_source_address_prefixes":      utils.FlattenStringSlice(item.ExpandedSourceAddressPrefix),
+			"expanded_destination_address_prefixes": utils.FlattenStringSlice(item.ExpandedDestinationAddressPrefix),
+		})
+	}
+
+	return results
+}
+
+// flattenEffectiveSecurityRuleValues combines the singular and plural forms of a value returned by the API (e.g.
+// `sourcePortRange` and `sourcePortRanges`), since only one of them is populated for a given Security Rule
+func flattenEffectiveSecurityRuleValues(single *string, multiple *[]string) []interface{} {
+	results := utils.FlattenStringSlice(multiple)
+	if single != nil && *single != "" {
+		results = append([]interface{}{*single}, results...)
+	}
+	return results
+}
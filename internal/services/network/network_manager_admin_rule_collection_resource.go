@@ -0,0 +1,245 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/adminrulecollections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/networkgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/securityadminconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkManagerAdminRuleCollectionModel struct {
+	Name                         string   `tfschema:"name"`
+	SecurityAdminConfigurationId string   `tfschema:"security_admin_configuration_id"`
+	NetworkGroupIds              []string `tfschema:"network_group_ids"`
+	Description                  string   `tfschema:"description"`
+}
+
+type NetworkManagerAdminRuleCollectionResource struct{}
+
+var _ sdk.ResourceWithUpdate = NetworkManagerAdminRuleCollectionResource{}
+
+func (r NetworkManagerAdminRuleCollectionResource) ResourceType() string {
+	return "azurerm_network_manager_admin_rule_collection"
+}
+
+func (r NetworkManagerAdminRuleCollectionResource) ModelObject() interface{} {
+	return &NetworkManagerAdminRuleCollectionModel{}
+}
+
+func (r NetworkManagerAdminRuleCollectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return adminrulecollections.ValidateRuleCollectionID
+}
+
+func (r NetworkManagerAdminRuleCollectionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"security_admin_configuration_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: securityadminconfigurations.ValidateSecurityAdminConfigurationID,
+		},
+
+		"network_group_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: networkgroups.ValidateNetworkGroupID,
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r NetworkManagerAdminRuleCollectionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NetworkManagerAdminRuleCollectionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model NetworkManagerAdminRuleCollectionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Network.ManagerAdminRuleCollectionsClient
+
+			configurationId, err := securityadminconfigurations.ParseSecurityAdminConfigurationID(model.SecurityAdminConfigurationId)
+			if err != nil {
+				return err
+			}
+
+			id := adminrulecollections.NewRuleCollectionID(configurationId.SubscriptionId, configurationId.ResourceGroupName, configurationId.NetworkManagerName, configurationId.SecurityAdminConfigurationName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := adminrulecollections.RuleCollection{
+				Properties: &adminrulecollections.RuleCollectionPropertiesFormat{
+					AppliesToGroups: expandNetworkManagerAdminRuleCollectionGroups(model.NetworkGroupIds),
+				},
+			}
+
+			if model.Description != "" {
+				properties.Properties.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r NetworkManagerAdminRuleCollectionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRuleCollectionsClient
+
+			id, err := adminrulecollections.ParseRuleCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model NetworkManagerAdminRuleCollectionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			properties := resp.Model
+			if properties == nil || properties.Properties == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			if metadata.ResourceData.HasChange("network_group_ids") {
+				properties.Properties.AppliesToGroups = expandNetworkManagerAdminRuleCollectionGroups(model.NetworkGroupIds)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				properties.Properties.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, *properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r NetworkManagerAdminRuleCollectionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRuleCollectionsClient
+
+			id, err := adminrulecollections.ParseRuleCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := NetworkManagerAdminRuleCollectionModel{
+				Name:                         id.RuleCollectionName,
+				SecurityAdminConfigurationId: securityadminconfigurations.NewSecurityAdminConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName, id.SecurityAdminConfigurationName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.NetworkGroupIds = flattenNetworkManagerAdminRuleCollectionGroups(props.AppliesToGroups)
+					state.Description = utils.NormalizeNilableString(props.Description)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r NetworkManagerAdminRuleCollectionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRuleCollectionsClient
+
+			id, err := adminrulecollections.ParseRuleCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandNetworkManagerAdminRuleCollectionGroups(input []string) *[]adminrulecollections.NetworkManagerSecurityGroupItem {
+	output := make([]adminrulecollections.NetworkManagerSecurityGroupItem, 0)
+	for _, v := range input {
+		output = append(output, adminrulecollections.NetworkManagerSecurityGroupItem{
+			NetworkGroupId: v,
+		})
+	}
+
+	return &output
+}
+
+func flattenNetworkManagerAdminRuleCollectionGroups(input *[]adminrulecollections.NetworkManagerSecurityGroupItem) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, v.NetworkGroupId)
+	}
+
+	return output
+}
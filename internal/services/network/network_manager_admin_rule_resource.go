@@ -0,0 +1,394 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/adminrulecollections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/adminrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkManagerAdminRuleModel struct {
+	Name                  string                                 `tfschema:"name"`
+	AdminRuleCollectionId string                                 `tfschema:"admin_rule_collection_id"`
+	Action                string                                 `tfschema:"action"`
+	Direction             string                                 `tfschema:"direction"`
+	Priority              int64                                  `tfschema:"priority"`
+	Protocol              string                                 `tfschema:"protocol"`
+	Source                []NetworkManagerAddressPrefixItemModel `tfschema:"source"`
+	Destination           []NetworkManagerAddressPrefixItemModel `tfschema:"destination"`
+	SourcePortRanges      []string                               `tfschema:"source_port_ranges"`
+	DestinationPortRanges []string                               `tfschema:"destination_port_ranges"`
+	Description           string                                 `tfschema:"description"`
+}
+
+type NetworkManagerAddressPrefixItemModel struct {
+	AddressPrefix     string `tfschema:"address_prefix"`
+	AddressPrefixType string `tfschema:"address_prefix_type"`
+}
+
+type NetworkManagerAdminRuleResource struct{}
+
+var _ sdk.ResourceWithUpdate = NetworkManagerAdminRuleResource{}
+
+func (r NetworkManagerAdminRuleResource) ResourceType() string {
+	return "azurerm_network_manager_admin_rule"
+}
+
+func (r NetworkManagerAdminRuleResource) ModelObject() interface{} {
+	return &NetworkManagerAdminRuleModel{}
+}
+
+func (r NetworkManagerAdminRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return adminrules.ValidateRuleID
+}
+
+func (r NetworkManagerAdminRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"admin_rule_collection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: adminrulecollections.ValidateRuleCollectionID,
+		},
+
+		"action": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(adminrules.SecurityConfigurationRuleAccessAllow),
+				string(adminrules.SecurityConfigurationRuleAccessAlwaysAllow),
+				string(adminrules.SecurityConfigurationRuleAccessDeny),
+			}, false),
+		},
+
+		"direction": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(adminrules.SecurityConfigurationRuleDirectionInbound),
+				string(adminrules.SecurityConfigurationRuleDirectionOutbound),
+			}, false),
+		},
+
+		"priority": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 4096),
+		},
+
+		"protocol": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(adminrules.SecurityConfigurationRuleProtocolAh),
+				string(adminrules.SecurityConfigurationRuleProtocolAny),
+				string(adminrules.SecurityConfigurationRuleProtocolEsp),
+				string(adminrules.SecurityConfigurationRuleProtocolIcmp),
+				string(adminrules.SecurityConfigurationRuleProtocolTcp),
+				string(adminrules.SecurityConfigurationRuleProtocolUdp),
+			}, false),
+		},
+
+		"source": networkManagerAddressPrefixItemSchema(),
+
+		"destination": networkManagerAddressPrefixItemSchema(),
+
+		"source_port_ranges": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"destination_port_ranges": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r NetworkManagerAdminRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NetworkManagerAdminRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model NetworkManagerAdminRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Network.ManagerAdminRulesClient
+
+			ruleCollectionId, err := adminrulecollections.ParseRuleCollectionID(model.AdminRuleCollectionId)
+			if err != nil {
+				return err
+			}
+
+			id := adminrules.NewRuleID(ruleCollectionId.SubscriptionId, ruleCollectionId.ResourceGroupName, ruleCollectionId.NetworkManagerName, ruleCollectionId.SecurityAdminConfigurationName, ruleCollectionId.RuleCollectionName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := adminrules.AdminRule{
+				Kind: adminrules.AdminRuleKindCustom,
+				Properties: &adminrules.AdminPropertiesFormat{
+					Access:                adminrules.SecurityConfigurationRuleAccess(model.Action),
+					DestinationPortRanges: &model.DestinationPortRanges,
+					Destinations:          expandNetworkManagerAddressPrefixItems(model.Destination),
+					Direction:             adminrules.SecurityConfigurationRuleDirection(model.Direction),
+					Priority:              utils.Int64(model.Priority),
+					Protocol:              adminrules.SecurityConfigurationRuleProtocol(model.Protocol),
+					SourcePortRanges:      &model.SourcePortRanges,
+					Sources:               expandNetworkManagerAddressPrefixItems(model.Source),
+				},
+			}
+
+			if model.Description != "" {
+				properties.Properties.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r NetworkManagerAdminRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRulesClient
+
+			id, err := adminrules.ParseRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model NetworkManagerAdminRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			properties := resp.Model
+			if properties == nil || properties.Properties == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			if metadata.ResourceData.HasChange("action") {
+				properties.Properties.Access = adminrules.SecurityConfigurationRuleAccess(model.Action)
+			}
+
+			if metadata.ResourceData.HasChange("direction") {
+				properties.Properties.Direction = adminrules.SecurityConfigurationRuleDirection(model.Direction)
+			}
+
+			if metadata.ResourceData.HasChange("priority") {
+				properties.Properties.Priority = utils.Int64(model.Priority)
+			}
+
+			if metadata.ResourceData.HasChange("protocol") {
+				properties.Properties.Protocol = adminrules.SecurityConfigurationRuleProtocol(model.Protocol)
+			}
+
+			if metadata.ResourceData.HasChange("source") {
+				properties.Properties.Sources = expandNetworkManagerAddressPrefixItems(model.Source)
+			}
+
+			if metadata.ResourceData.HasChange("destination") {
+				properties.Properties.Destinations = expandNetworkManagerAddressPrefixItems(model.Destination)
+			}
+
+			if metadata.ResourceData.HasChange("source_port_ranges") {
+				properties.Properties.SourcePortRanges = &model.SourcePortRanges
+			}
+
+			if metadata.ResourceData.HasChange("destination_port_ranges") {
+				properties.Properties.DestinationPortRanges = &model.DestinationPortRanges
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				properties.Properties.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, *properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r NetworkManagerAdminRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRulesClient
+
+			id, err := adminrules.ParseRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := NetworkManagerAdminRuleModel{
+				Name:                  id.RuleName,
+				AdminRuleCollectionId: adminrulecollections.NewRuleCollectionID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName, id.SecurityAdminConfigurationName, id.RuleCollectionName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Action = string(props.Access)
+					state.Direction = string(props.Direction)
+					state.Protocol = string(props.Protocol)
+					state.Source = flattenNetworkManagerAddressPrefixItems(props.Sources)
+					state.Destination = flattenNetworkManagerAddressPrefixItems(props.Destinations)
+					state.Description = utils.NormalizeNilableString(props.Description)
+
+					if props.Priority != nil {
+						state.Priority = *props.Priority
+					}
+
+					state.SourcePortRanges = make([]string, 0)
+					if props.SourcePortRanges != nil {
+						state.SourcePortRanges = *props.SourcePortRanges
+					}
+
+					state.DestinationPortRanges = make([]string, 0)
+					if props.DestinationPortRanges != nil {
+						state.DestinationPortRanges = *props.DestinationPortRanges
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r NetworkManagerAdminRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerAdminRulesClient
+
+			id, err := adminrules.ParseRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func networkManagerAddressPrefixItemSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"address_prefix": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"address_prefix_type": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(adminrules.AddressPrefixTypeIPPrefix),
+						string(adminrules.AddressPrefixTypeServiceTag),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func expandNetworkManagerAddressPrefixItems(input []NetworkManagerAddressPrefixItemModel) *[]adminrules.AddressPrefixItem {
+	output := make([]adminrules.AddressPrefixItem, 0)
+	for _, v := range input {
+		addressPrefixType := adminrules.AddressPrefixType(v.AddressPrefixType)
+		output = append(output, adminrules.AddressPrefixItem{
+			AddressPrefix:     utils.String(v.AddressPrefix),
+			AddressPrefixType: &addressPrefixType,
+		})
+	}
+
+	return &output
+}
+
+func flattenNetworkManagerAddressPrefixItems(input *[]adminrules.AddressPrefixItem) []NetworkManagerAddressPrefixItemModel {
+	output := make([]NetworkManagerAddressPrefixItemModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		item := NetworkManagerAddressPrefixItemModel{
+			AddressPrefix: utils.NormalizeNilableString(v.AddressPrefix),
+		}
+		if v.AddressPrefixType != nil {
+			item.AddressPrefixType = string(*v.AddressPrefixType)
+		}
+
+		output = append(output, item)
+	}
+
+	return output
+}
@@ -0,0 +1,169 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/adminrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkManagerAdminRuleResource struct{}
+
+func testAccNetworkManagerAdminRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_admin_rule", "test")
+	r := NetworkManagerAdminRuleResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerAdminRule_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_admin_rule", "test")
+	r := NetworkManagerAdminRuleResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerAdminRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_admin_rule", "test")
+	r := NetworkManagerAdminRuleResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("source.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("source.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerAdminRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_admin_rule", "test")
+	r := NetworkManagerAdminRuleResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r NetworkManagerAdminRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := adminrules.ParseRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ManagerAdminRulesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r NetworkManagerAdminRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_admin_rule" "test" {
+  name                     = "acctest-nmar-%d"
+  admin_rule_collection_id = azurerm_network_manager_admin_rule_collection.test.id
+  action                   = "Deny"
+  direction                = "Inbound"
+  priority                 = 100
+  protocol                 = "Tcp"
+}
+`, NetworkManagerAdminRuleCollectionResource{}.basic(data), data.RandomInteger)
+}
+
+func (r NetworkManagerAdminRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_admin_rule" "test" {
+  name                     = "acctest-nmar-%d"
+  admin_rule_collection_id = azurerm_network_manager_admin_rule_collection.test.id
+  action                   = "AlwaysAllow"
+  direction                = "Outbound"
+  priority                 = 200
+  protocol                 = "Udp"
+  source_port_ranges       = ["80", "1024-65535"]
+  destination_port_ranges  = ["53"]
+  description              = "test admin rule"
+
+  source {
+    address_prefix_type = "ServiceTag"
+    address_prefix      = "Internet"
+  }
+
+  source {
+    address_prefix_type = "IPPrefix"
+    address_prefix      = "10.0.0.0/24"
+  }
+
+  destination {
+    address_prefix_type = "IPPrefix"
+    address_prefix      = "10.1.0.1"
+  }
+}
+`, NetworkManagerAdminRuleCollectionResource{}.basic(data), data.RandomInteger)
+}
+
+func (r NetworkManagerAdminRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_admin_rule" "import" {
+  name                     = azurerm_network_manager_admin_rule.test.name
+  admin_rule_collection_id = azurerm_network_manager_admin_rule.test.admin_rule_collection_id
+  action                   = azurerm_network_manager_admin_rule.test.action
+  direction                = azurerm_network_manager_admin_rule.test.direction
+  priority                 = azurerm_network_manager_admin_rule.test.priority
+  protocol                 = azurerm_network_manager_admin_rule.test.protocol
+}
+`, r.basic(data))
+}
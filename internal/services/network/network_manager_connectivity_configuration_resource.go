@@ -0,0 +1,429 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/connectivityconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/networkgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkManagerConnectivityConfigurationModel struct {
+	Name                         string                                     `tfschema:"name"`
+	NetworkManagerId             string                                     `tfschema:"network_manager_id"`
+	ConnectivityTopology         string                                     `tfschema:"connectivity_topology"`
+	AppliesToGroup               []NetworkManagerConnectivityGroupItemModel `tfschema:"applies_to_group"`
+	Hub                          []NetworkManagerConnectivityHubModel       `tfschema:"hub"`
+	DeleteExistingPeeringEnabled bool                                       `tfschema:"delete_existing_peering_enabled"`
+	GlobalMeshEnabled            bool                                       `tfschema:"global_mesh_enabled"`
+	Description                  string                                     `tfschema:"description"`
+}
+
+type NetworkManagerConnectivityGroupItemModel struct {
+	NetworkGroupId    string `tfschema:"network_group_id"`
+	GroupConnectivity string `tfschema:"group_connectivity"`
+	UseHubGateway     bool   `tfschema:"use_hub_gateway"`
+	GlobalMeshEnabled bool   `tfschema:"global_mesh_enabled"`
+}
+
+type NetworkManagerConnectivityHubModel struct {
+	ResourceId   string `tfschema:"resource_id"`
+	ResourceType string `tfschema:"resource_type"`
+}
+
+type NetworkManagerConnectivityConfigurationResource struct{}
+
+var _ sdk.ResourceWithUpdate = NetworkManagerConnectivityConfigurationResource{}
+
+func (r NetworkManagerConnectivityConfigurationResource) ResourceType() string {
+	return "azurerm_network_manager_connectivity_configuration"
+}
+
+func (r NetworkManagerConnectivityConfigurationResource) ModelObject() interface{} {
+	return &NetworkManagerConnectivityConfigurationModel{}
+}
+
+func (r NetworkManagerConnectivityConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return connectivityconfigurations.ValidateConnectivityConfigurationID
+}
+
+func (r NetworkManagerConnectivityConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"network_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkmanagers.ValidateNetworkManagerID,
+		},
+
+		"connectivity_topology": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(connectivityconfigurations.ConnectivityTopologyHubAndSpoke),
+				string(connectivityconfigurations.ConnectivityTopologyMesh),
+			}, false),
+		},
+
+		"applies_to_group": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"network_group_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: networkgroups.ValidateNetworkGroupID,
+					},
+
+					"group_connectivity": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(connectivityconfigurations.GroupConnectivityNone),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(connectivityconfigurations.GroupConnectivityDirectlyConnected),
+							string(connectivityconfigurations.GroupConnectivityNone),
+						}, false),
+					},
+
+					"use_hub_gateway": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"global_mesh_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
+		"hub": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"resource_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: azure.ValidateResourceID,
+					},
+
+					"resource_type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"delete_existing_peering_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"global_mesh_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r NetworkManagerConnectivityConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NetworkManagerConnectivityConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model NetworkManagerConnectivityConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Network.ManagerConnectivityConfigurationsClient
+
+			networkManagerId, err := networkmanagers.ParseNetworkManagerID(model.NetworkManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := connectivityconfigurations.NewConnectivityConfigurationID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroupName, networkManagerId.NetworkManagerName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if model.ConnectivityTopology == string(connectivityconfigurations.ConnectivityTopologyHubAndSpoke) && len(model.Hub) == 0 {
+				return fmt.Errorf("`hub` must be specified when `connectivity_topology` is `%s`", connectivityconfigurations.ConnectivityTopologyHubAndSpoke)
+			}
+
+			deleteExistingPeering := expandNetworkManagerDeleteExistingPeering(model.DeleteExistingPeeringEnabled)
+			isGlobal := expandNetworkManagerIsGlobal(model.GlobalMeshEnabled)
+			properties := connectivityconfigurations.ConnectivityConfiguration{
+				Properties: &connectivityconfigurations.ConnectivityConfigurationProperties{
+					AppliesToGroups:       expandNetworkManagerConnectivityGroupItems(model.AppliesToGroup),
+					ConnectivityTopology:  connectivityconfigurations.ConnectivityTopology(model.ConnectivityTopology),
+					DeleteExistingPeering: &deleteExistingPeering,
+					Hubs:                  expandNetworkManagerConnectivityHubs(model.Hub),
+					IsGlobal:              &isGlobal,
+				},
+			}
+
+			if model.Description != "" {
+				properties.Properties.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r NetworkManagerConnectivityConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerConnectivityConfigurationsClient
+
+			id, err := connectivityconfigurations.ParseConnectivityConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model NetworkManagerConnectivityConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			properties := resp.Model
+			if properties == nil || properties.Properties == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			if model.ConnectivityTopology == string(connectivityconfigurations.ConnectivityTopologyHubAndSpoke) && len(model.Hub) == 0 {
+				return fmt.Errorf("`hub` must be specified when `connectivity_topology` is `%s`", connectivityconfigurations.ConnectivityTopologyHubAndSpoke)
+			}
+
+			if metadata.ResourceData.HasChange("connectivity_topology") {
+				properties.Properties.ConnectivityTopology = connectivityconfigurations.ConnectivityTopology(model.ConnectivityTopology)
+			}
+
+			if metadata.ResourceData.HasChange("applies_to_group") {
+				properties.Properties.AppliesToGroups = expandNetworkManagerConnectivityGroupItems(model.AppliesToGroup)
+			}
+
+			if metadata.ResourceData.HasChange("hub") {
+				properties.Properties.Hubs = expandNetworkManagerConnectivityHubs(model.Hub)
+			}
+
+			if metadata.ResourceData.HasChange("delete_existing_peering_enabled") {
+				deleteExistingPeering := expandNetworkManagerDeleteExistingPeering(model.DeleteExistingPeeringEnabled)
+				properties.Properties.DeleteExistingPeering = &deleteExistingPeering
+			}
+
+			if metadata.ResourceData.HasChange("global_mesh_enabled") {
+				isGlobal := expandNetworkManagerIsGlobal(model.GlobalMeshEnabled)
+				properties.Properties.IsGlobal = &isGlobal
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				properties.Properties.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, *properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r NetworkManagerConnectivityConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerConnectivityConfigurationsClient
+
+			id, err := connectivityconfigurations.ParseConnectivityConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := NetworkManagerConnectivityConfigurationModel{
+				Name:             id.ConnectivityConfigurationName,
+				NetworkManagerId: networkmanagers.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ConnectivityTopology = string(props.ConnectivityTopology)
+					state.AppliesToGroup = flattenNetworkManagerConnectivityGroupItems(props.AppliesToGroups)
+					state.Hub = flattenNetworkManagerConnectivityHubs(props.Hubs)
+					state.DeleteExistingPeeringEnabled = props.DeleteExistingPeering != nil && *props.DeleteExistingPeering == connectivityconfigurations.DeleteExistingPeeringTrue
+					state.GlobalMeshEnabled = props.IsGlobal != nil && *props.IsGlobal == connectivityconfigurations.IsGlobalTrue
+					state.Description = utils.NormalizeNilableString(props.Description)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r NetworkManagerConnectivityConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerConnectivityConfigurationsClient
+
+			id, err := connectivityconfigurations.ParseConnectivityConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandNetworkManagerDeleteExistingPeering(input bool) connectivityconfigurations.DeleteExistingPeering {
+	if input {
+		return connectivityconfigurations.DeleteExistingPeeringTrue
+	}
+	return connectivityconfigurations.DeleteExistingPeeringFalse
+}
+
+func expandNetworkManagerIsGlobal(input bool) connectivityconfigurations.IsGlobal {
+	if input {
+		return connectivityconfigurations.IsGlobalTrue
+	}
+	return connectivityconfigurations.IsGlobalFalse
+}
+
+func expandNetworkManagerConnectivityGroupItems(input []NetworkManagerConnectivityGroupItemModel) *[]connectivityconfigurations.ConnectivityGroupItem {
+	output := make([]connectivityconfigurations.ConnectivityGroupItem, 0)
+	for _, v := range input {
+		groupConnectivity := connectivityconfigurations.GroupConnectivity(v.GroupConnectivity)
+		isGlobal := expandNetworkManagerIsGlobal(v.GlobalMeshEnabled)
+		useHubGateway := connectivityconfigurations.UseHubGatewayFalse
+		if v.UseHubGateway {
+			useHubGateway = connectivityconfigurations.UseHubGatewayTrue
+		}
+
+		output = append(output, connectivityconfigurations.ConnectivityGroupItem{
+			GroupConnectivity: &groupConnectivity,
+			IsGlobal:          &isGlobal,
+			NetworkGroupId:    utils.String(v.NetworkGroupId),
+			UseHubGateway:     &useHubGateway,
+		})
+	}
+
+	return &output
+}
+
+func flattenNetworkManagerConnectivityGroupItems(input *[]connectivityconfigurations.ConnectivityGroupItem) []NetworkManagerConnectivityGroupItemModel {
+	output := make([]NetworkManagerConnectivityGroupItemModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		item := NetworkManagerConnectivityGroupItemModel{
+			NetworkGroupId:    utils.NormalizeNilableString(v.NetworkGroupId),
+			GroupConnectivity: string(connectivityconfigurations.GroupConnectivityNone),
+			UseHubGateway:     v.UseHubGateway != nil && *v.UseHubGateway == connectivityconfigurations.UseHubGatewayTrue,
+			GlobalMeshEnabled: v.IsGlobal != nil && *v.IsGlobal == connectivityconfigurations.IsGlobalTrue,
+		}
+
+		if v.GroupConnectivity != nil {
+			item.GroupConnectivity = string(*v.GroupConnectivity)
+		}
+
+		output = append(output, item)
+	}
+
+	return output
+}
+
+func expandNetworkManagerConnectivityHubs(input []NetworkManagerConnectivityHubModel) *[]connectivityconfigurations.Hub {
+	output := make([]connectivityconfigurations.Hub, 0)
+	for _, v := range input {
+		output = append(output, connectivityconfigurations.Hub{
+			ResourceId:   utils.String(v.ResourceId),
+			ResourceType: utils.String(v.ResourceType),
+		})
+	}
+
+	return &output
+}
+
+func flattenNetworkManagerConnectivityHubs(input *[]connectivityconfigurations.Hub) []NetworkManagerConnectivityHubModel {
+	output := make([]NetworkManagerConnectivityHubModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, NetworkManagerConnectivityHubModel{
+			ResourceId:   utils.NormalizeNilableString(v.ResourceId),
+			ResourceType: utils.NormalizeNilableString(v.ResourceType),
+		})
+	}
+
+	return output
+}
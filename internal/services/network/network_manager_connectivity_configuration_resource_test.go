@@ -0,0 +1,175 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/connectivityconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkManagerConnectivityConfigurationResource struct{}
+
+func testAccNetworkManagerConnectivityConfiguration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_connectivity_configuration", "test")
+	r := NetworkManagerConnectivityConfigurationResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerConnectivityConfiguration_hubAndSpoke(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_connectivity_configuration", "test")
+	r := NetworkManagerConnectivityConfigurationResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.hubAndSpoke(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerConnectivityConfiguration_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_connectivity_configuration", "test")
+	r := NetworkManagerConnectivityConfigurationResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.hubAndSpoke(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("connectivity_topology").HasValue("HubAndSpoke"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("connectivity_topology").HasValue("Mesh"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerConnectivityConfiguration_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_connectivity_configuration", "test")
+	r := NetworkManagerConnectivityConfigurationResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r NetworkManagerConnectivityConfigurationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := connectivityconfigurations.ParseConnectivityConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ManagerConnectivityConfigurationsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r NetworkManagerConnectivityConfigurationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_virtual_network" "hub" {
+  name                = "acctestvnet-hub-%[2]d"
+  address_space       = ["10.2.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, NetworkManagerNetworkGroupResource{}.complete(data), data.RandomInteger)
+}
+
+func (r NetworkManagerConnectivityConfigurationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_connectivity_configuration" "test" {
+  name                  = "acctest-nmcc-%d"
+  network_manager_id    = azurerm_network_manager.test.id
+  connectivity_topology = "Mesh"
+
+  applies_to_group {
+    network_group_id = azurerm_network_manager_network_group.test.id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkManagerConnectivityConfigurationResource) hubAndSpoke(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_connectivity_configuration" "test" {
+  name                            = "acctest-nmcc-%d"
+  network_manager_id              = azurerm_network_manager.test.id
+  connectivity_topology           = "HubAndSpoke"
+  delete_existing_peering_enabled = true
+  description                     = "test connectivity configuration"
+
+  applies_to_group {
+    network_group_id   = azurerm_network_manager_network_group.test.id
+    group_connectivity = "DirectlyConnected"
+  }
+
+  hub {
+    resource_id   = azurerm_virtual_network.hub.id
+    resource_type = "Microsoft.Network/virtualNetworks"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkManagerConnectivityConfigurationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_connectivity_configuration" "import" {
+  name                  = azurerm_network_manager_connectivity_configuration.test.name
+  network_manager_id    = azurerm_network_manager_connectivity_configuration.test.network_manager_id
+  connectivity_topology = azurerm_network_manager_connectivity_configuration.test.connectivity_topology
+
+  applies_to_group {
+    network_group_id = azurerm_network_manager_network_group.test.id
+  }
+}
+`, r.basic(data))
+}
@@ -0,0 +1,388 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/connectivityconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/networkmanagercommits"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/networkmanagerdeploymentstatus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/securityadminconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type NetworkManagerDeploymentModel struct {
+	NetworkManagerId string            `tfschema:"network_manager_id"`
+	Location         string            `tfschema:"location"`
+	ScopeAccess      string            `tfschema:"scope_access"`
+	ConfigurationIds []string          `tfschema:"configuration_ids"`
+	Triggers         map[string]string `tfschema:"triggers"`
+}
+
+type NetworkManagerDeploymentResource struct{}
+
+var _ sdk.ResourceWithUpdate = NetworkManagerDeploymentResource{}
+
+func (r NetworkManagerDeploymentResource) ResourceType() string {
+	return "azurerm_network_manager_deployment"
+}
+
+func (r NetworkManagerDeploymentResource) ModelObject() interface{} {
+	return &NetworkManagerDeploymentModel{}
+}
+
+func (r NetworkManagerDeploymentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NetworkManagerDeploymentID
+}
+
+func (r NetworkManagerDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkmanagers.ValidateNetworkManagerID,
+		},
+
+		"location": location.Schema(),
+
+		"scope_access": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(networkmanagercommits.ConfigurationTypeConnectivity),
+				string(networkmanagercommits.ConfigurationTypeSecurityAdmin),
+			}, false),
+		},
+
+		"configuration_ids": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r NetworkManagerDeploymentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NetworkManagerDeploymentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 1 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model NetworkManagerDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			statusClient := metadata.Client.Network.ManagerDeploymentStatusClient
+
+			networkManagerId, err := networkmanagers.ParseNetworkManagerID(model.NetworkManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNetworkManagerDeploymentID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroupName, networkManagerId.NetworkManagerName, location.Normalize(model.Location), model.ScopeAccess)
+
+			locks.ByID(networkManagerId.ID())
+			defer locks.UnlockByID(networkManagerId.ID())
+
+			existing, err := getNetworkManagerDeploymentStatus(ctx, statusClient, id)
+			if err != nil {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if existing != nil && existing.ConfigurationIds != nil && len(*existing.ConfigurationIds) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := commitNetworkManagerDeployment(ctx, metadata, id, model.ConfigurationIds); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r NetworkManagerDeploymentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 1 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.NetworkManagerDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model NetworkManagerDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			networkManagerId := networkmanagers.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName)
+			locks.ByID(networkManagerId.ID())
+			defer locks.UnlockByID(networkManagerId.ID())
+
+			if metadata.ResourceData.HasChange("configuration_ids") {
+				if err := commitNetworkManagerDeployment(ctx, metadata, *id, model.ConfigurationIds); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r NetworkManagerDeploymentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			statusClient := metadata.Client.Network.ManagerDeploymentStatusClient
+
+			id, err := parse.NetworkManagerDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			deployment, err := getNetworkManagerDeploymentStatus(ctx, statusClient, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if deployment == nil || deployment.ConfigurationIds == nil || len(*deployment.ConfigurationIds) == 0 {
+				log.Printf("[DEBUG] %s was not found - removing from state", *id)
+				return metadata.MarkAsGone(id)
+			}
+
+			configurationIds, err := normalizeNetworkManagerConfigurationIds(id.ScopeAccess, *deployment.ConfigurationIds)
+			if err != nil {
+				return err
+			}
+
+			state := NetworkManagerDeploymentModel{
+				NetworkManagerId: networkmanagers.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName).ID(),
+				Location:         location.Normalize(id.Location),
+				ScopeAccess:      id.ScopeAccess,
+				ConfigurationIds: configurationIds,
+			}
+
+			// `triggers` isn't returned by the API and only exists to force a new deployment
+			var config NetworkManagerDeploymentModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.Triggers = config.Triggers
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r NetworkManagerDeploymentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 1 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.NetworkManagerDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			networkManagerId := networkmanagers.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName)
+			locks.ByID(networkManagerId.ID())
+			defer locks.UnlockByID(networkManagerId.ID())
+
+			// committing an empty list of configurations removes the deployed configurations from the region
+			if err := commitNetworkManagerDeployment(ctx, metadata, *id, []string{}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func commitNetworkManagerDeployment(ctx context.Context, metadata sdk.ResourceMetaData, id parse.NetworkManagerDeploymentId, configurationIds []string) error {
+	client := metadata.Client.Network.ManagerCommitsClient
+	statusClient := metadata.Client.Network.ManagerDeploymentStatusClient
+
+	commitType := networkmanagercommits.ConfigurationType(id.ScopeAccess)
+	input := networkmanagercommits.NetworkManagerCommit{
+		CommitType:       &commitType,
+		ConfigurationIds: &configurationIds,
+		TargetLocations:  &[]string{id.Location},
+	}
+
+	networkManagerId := networkmanagercommits.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName)
+	if _, err := client.Post(ctx, networkManagerId, input); err != nil {
+		return fmt.Errorf("committing the configurations: %+v", err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(networkmanagerdeploymentstatus.DeploymentStatusNotStarted),
+			string(networkmanagerdeploymentstatus.DeploymentStatusDeploying),
+		},
+		Target:     []string{string(networkmanagerdeploymentstatus.DeploymentStatusDeployed)},
+		Refresh:    networkManagerDeploymentStateRefreshFunc(ctx, statusClient, id, configurationIds),
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the deployment to complete: %+v", err)
+	}
+
+	return nil
+}
+
+func getNetworkManagerDeploymentStatus(ctx context.Context, client *networkmanagerdeploymentstatus.NetworkManagerDeploymentStatusClient, id parse.NetworkManagerDeploymentId) (*networkmanagerdeploymentstatus.NetworkManagerDeploymentStatus, error) {
+	networkManagerId := networkmanagerdeploymentstatus.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName)
+	input := networkmanagerdeploymentstatus.NetworkManagerDeploymentStatusParameter{
+		DeploymentTypes: &[]networkmanagerdeploymentstatus.ConfigurationType{
+			networkmanagerdeploymentstatus.ConfigurationType(id.ScopeAccess),
+		},
+		Regions: &[]string{id.Location},
+	}
+
+	resp, err := client.List(ctx, networkManagerId, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Model == nil || resp.Model.Value == nil {
+		return nil, nil
+	}
+
+	for _, v := range *resp.Model.Value {
+		if v.Region == nil || location.Normalize(*v.Region) != id.Location {
+			continue
+		}
+		if v.DeploymentType == nil || !strings.EqualFold(string(*v.DeploymentType), id.ScopeAccess) {
+			continue
+		}
+
+		deployment := v
+		return &deployment, nil
+	}
+
+	return nil, nil
+}
+
+func networkManagerDeploymentStateRefreshFunc(ctx context.Context, client *networkmanagerdeploymentstatus.NetworkManagerDeploymentStatusClient, id parse.NetworkManagerDeploymentId, configurationIds []string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		deployment, err := getNetworkManagerDeploymentStatus(ctx, client, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving the deployment status of %s: %+v", id, err)
+		}
+
+		if deployment == nil || deployment.DeploymentStatus == nil {
+			// once all of the configurations have been removed (e.g. during deletion) the deployment is no longer returned
+			if len(configurationIds) == 0 {
+				return "", string(networkmanagerdeploymentstatus.DeploymentStatusDeployed), nil
+			}
+
+			return "", string(networkmanagerdeploymentstatus.DeploymentStatusNotStarted), nil
+		}
+
+		status := *deployment.DeploymentStatus
+		if status == networkmanagerdeploymentstatus.DeploymentStatusFailed {
+			errorMessage := ""
+			if deployment.ErrorMessage != nil {
+				errorMessage = *deployment.ErrorMessage
+			}
+			return deployment, string(status), fmt.Errorf("deployment of %s failed: %s", id, errorMessage)
+		}
+
+		// the status of the previous commit is returned until the new commit has been picked up
+		if status == networkmanagerdeploymentstatus.DeploymentStatusDeployed && !networkManagerConfigurationIdsMatch(deployment.ConfigurationIds, configurationIds) {
+			return deployment, string(networkmanagerdeploymentstatus.DeploymentStatusNotStarted), nil
+		}
+
+		return deployment, string(status), nil
+	}
+}
+
+// normalizeNetworkManagerConfigurationIds parses the Configuration IDs returned by the API, which can differ in casing from
+// those which were committed, using the ID type for the Scope Access of the Deployment
+func normalizeNetworkManagerConfigurationIds(scopeAccess string, input []string) ([]string, error) {
+	output := make([]string, 0)
+	for _, v := range input {
+		switch {
+		case strings.EqualFold(scopeAccess, string(networkmanagercommits.ConfigurationTypeConnectivity)):
+			configurationId, err := connectivityconfigurations.ParseConnectivityConfigurationIDInsensitively(v)
+			if err != nil {
+				return nil, err
+			}
+			output = append(output, configurationId.ID())
+
+		case strings.EqualFold(scopeAccess, string(networkmanagercommits.ConfigurationTypeSecurityAdmin)):
+			configurationId, err := securityadminconfigurations.ParseSecurityAdminConfigurationIDInsensitively(v)
+			if err != nil {
+				return nil, err
+			}
+			output = append(output, configurationId.ID())
+
+		default:
+			output = append(output, v)
+		}
+	}
+
+	return output, nil
+}
+
+func networkManagerConfigurationIdsMatch(actual *[]string, expected []string) bool {
+	actualIds := make([]string, 0)
+	if actual != nil {
+		actualIds = *actual
+	}
+
+	if len(actualIds) != len(expected) {
+		return false
+	}
+
+	for _, e := range expected {
+		found := false
+		for _, a := range actualIds {
+			if strings.EqualFold(a, e) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,282 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/networkgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkManagerNetworkGroupModel struct {
+	Name                  string   `tfschema:"name"`
+	NetworkManagerId      string   `tfschema:"network_manager_id"`
+	Description           string   `tfschema:"description"`
+	MemberType            string   `tfschema:"member_type"`
+	StaticMemberIds       []string `tfschema:"static_member_ids"`
+	ConditionalMembership string   `tfschema:"conditional_membership"`
+}
+
+type NetworkManagerNetworkGroupResource struct{}
+
+var _ sdk.ResourceWithUpdate = NetworkManagerNetworkGroupResource{}
+
+func (r NetworkManagerNetworkGroupResource) ResourceType() string {
+	return "azurerm_network_manager_network_group"
+}
+
+func (r NetworkManagerNetworkGroupResource) ModelObject() interface{} {
+	return &NetworkManagerNetworkGroupModel{}
+}
+
+func (r NetworkManagerNetworkGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networkgroups.ValidateNetworkGroupID
+}
+
+func (r NetworkManagerNetworkGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"network_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkmanagers.ValidateNetworkManagerID,
+		},
+
+		"member_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  string(networkgroups.MemberTypeVirtualNetwork),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(networkgroups.MemberTypeSubnet),
+				string(networkgroups.MemberTypeVirtualNetwork),
+			}, false),
+		},
+
+		"static_member_ids": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+
+		"conditional_membership": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r NetworkManagerNetworkGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NetworkManagerNetworkGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model NetworkManagerNetworkGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Network.ManagerNetworkGroupsClient
+
+			networkManagerId, err := networkmanagers.ParseNetworkManagerID(model.NetworkManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := networkgroups.NewNetworkGroupID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroupName, networkManagerId.NetworkManagerName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			memberType := networkgroups.MemberType(model.MemberType)
+			properties := networkgroups.NetworkGroup{
+				Properties: &networkgroups.NetworkGroupProperties{
+					GroupMembers: expandNetworkManagerNetworkGroupMembers(model.StaticMemberIds),
+					MemberType:   &memberType,
+				},
+			}
+
+			if model.ConditionalMembership != "" {
+				properties.Properties.ConditionalMembership = utils.String(model.ConditionalMembership)
+			}
+
+			if model.Description != "" {
+				properties.Properties.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r NetworkManagerNetworkGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerNetworkGroupsClient
+
+			id, err := networkgroups.ParseNetworkGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model NetworkManagerNetworkGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			properties := resp.Model
+			if properties == nil || properties.Properties == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			if metadata.ResourceData.HasChange("static_member_ids") {
+				properties.Properties.GroupMembers = expandNetworkManagerNetworkGroupMembers(model.StaticMemberIds)
+			}
+
+			if metadata.ResourceData.HasChange("conditional_membership") {
+				properties.Properties.ConditionalMembership = utils.String(model.ConditionalMembership)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				properties.Properties.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, *properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r NetworkManagerNetworkGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerNetworkGroupsClient
+
+			id, err := networkgroups.ParseNetworkGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := NetworkManagerNetworkGroupModel{
+				Name:             id.NetworkGroupName,
+				NetworkManagerId: networkmanagers.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName).ID(),
+				MemberType:       string(networkgroups.MemberTypeVirtualNetwork),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ConditionalMembership = utils.NormalizeNilableString(props.ConditionalMembership)
+					state.Description = utils.NormalizeNilableString(props.Description)
+					state.StaticMemberIds = flattenNetworkManagerNetworkGroupMembers(props.GroupMembers)
+
+					if props.MemberType != nil {
+						state.MemberType = string(*props.MemberType)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r NetworkManagerNetworkGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerNetworkGroupsClient
+
+			id, err := networkgroups.ParseNetworkGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandNetworkManagerNetworkGroupMembers(input []string) *[]networkgroups.GroupMembersItem {
+	output := make([]networkgroups.GroupMembersItem, 0)
+	for _, v := range input {
+		output = append(output, networkgroups.GroupMembersItem{
+			ResourceId: utils.String(v),
+		})
+	}
+
+	return &output
+}
+
+func flattenNetworkManagerNetworkGroupMembers(input *[]networkgroups.GroupMembersItem) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		if v.ResourceId != nil {
+			output = append(output, *v.ResourceId)
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,173 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/networkgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkManagerNetworkGroupResource struct{}
+
+func testAccNetworkManagerNetworkGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_network_group", "test")
+	r := NetworkManagerNetworkGroupResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerNetworkGroup_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_network_group", "test")
+	r := NetworkManagerNetworkGroupResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerNetworkGroup_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_network_group", "test")
+	r := NetworkManagerNetworkGroupResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("static_member_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("static_member_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerNetworkGroup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_network_group", "test")
+	r := NetworkManagerNetworkGroupResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r NetworkManagerNetworkGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := networkgroups.ParseNetworkGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ManagerNetworkGroupsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r NetworkManagerNetworkGroupResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[2]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_virtual_network" "second" {
+  name                = "acctestvnet2-%[2]d"
+  address_space       = ["10.1.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, NetworkManagerResource{}.complete(data), data.RandomInteger)
+}
+
+func (r NetworkManagerNetworkGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_network_group" "test" {
+  name               = "acctest-nmng-%d"
+  network_manager_id = azurerm_network_manager.test.id
+  static_member_ids  = [azurerm_virtual_network.test.id]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkManagerNetworkGroupResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_network_group" "test" {
+  name               = "acctest-nmng-%d"
+  network_manager_id = azurerm_network_manager.test.id
+  member_type        = "VirtualNetwork"
+  static_member_ids  = [azurerm_virtual_network.test.id, azurerm_virtual_network.second.id]
+  description        = "test network group"
+
+  conditional_membership = jsonencode({
+    allOf = [
+      {
+        field    = "tags['environment']"
+        contains = "production"
+      }
+    ]
+  })
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkManagerNetworkGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_network_group" "import" {
+  name               = azurerm_network_manager_network_group.test.name
+  network_manager_id = azurerm_network_manager_network_group.test.network_manager_id
+  static_member_ids  = azurerm_network_manager_network_group.test.static_member_ids
+}
+`, r.basic(data))
+}
@@ -0,0 +1,329 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	managementGroupValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkManagerModel struct {
+	Name              string                     `tfschema:"name"`
+	ResourceGroupName string                     `tfschema:"resource_group_name"`
+	Location          string                     `tfschema:"location"`
+	Description       string                     `tfschema:"description"`
+	Scope             []NetworkManagerScopeModel `tfschema:"scope"`
+	ScopeAccesses     []string                   `tfschema:"scope_accesses"`
+	Tags              map[string]string          `tfschema:"tags"`
+}
+
+type NetworkManagerScopeModel struct {
+	ManagementGroupIds []string `tfschema:"management_group_ids"`
+	SubscriptionIds    []string `tfschema:"subscription_ids"`
+}
+
+type NetworkManagerResource struct{}
+
+var _ sdk.ResourceWithUpdate = NetworkManagerResource{}
+
+func (r NetworkManagerResource) ResourceType() string {
+	return "azurerm_network_manager"
+}
+
+func (r NetworkManagerResource) ModelObject() interface{} {
+	return &NetworkManagerModel{}
+}
+
+func (r NetworkManagerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networkmanagers.ValidateNetworkManagerID
+}
+
+func (r NetworkManagerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"scope": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"management_group_ids": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: managementGroupValidate.ManagementGroupID,
+						},
+						AtLeastOneOf: []string{"scope.0.management_group_ids", "scope.0.subscription_ids"},
+					},
+
+					"subscription_ids": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: commonids.ValidateSubscriptionID,
+						},
+						AtLeastOneOf: []string{"scope.0.management_group_ids", "scope.0.subscription_ids"},
+					},
+				},
+			},
+		},
+
+		"scope_accesses": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(networkmanagers.ConfigurationTypeConnectivity),
+					string(networkmanagers.ConfigurationTypeSecurityAdmin),
+				}, false),
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r NetworkManagerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NetworkManagerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model NetworkManagerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Network.ManagersClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := networkmanagers.NewNetworkManagerID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := networkmanagers.NetworkManager{
+				Location: utils.String(location.Normalize(model.Location)),
+				Properties: &networkmanagers.NetworkManagerProperties{
+					NetworkManagerScopeAccesses: expandNetworkManagerScopeAccesses(model.ScopeAccesses),
+					NetworkManagerScopes:        expandNetworkManagerScope(model.Scope),
+				},
+				Tags: &model.Tags,
+			}
+
+			if model.Description != "" {
+				properties.Properties.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r NetworkManagerResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+
+			id, err := networkmanagers.ParseNetworkManagerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model NetworkManagerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			properties := resp.Model
+			if properties == nil || properties.Properties == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				properties.Properties.Description = utils.String(model.Description)
+			}
+
+			if metadata.ResourceData.HasChange("scope") {
+				properties.Properties.NetworkManagerScopes = expandNetworkManagerScope(model.Scope)
+			}
+
+			if metadata.ResourceData.HasChange("scope_accesses") {
+				properties.Properties.NetworkManagerScopeAccesses = expandNetworkManagerScopeAccesses(model.ScopeAccesses)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				properties.Tags = &model.Tags
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, *properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r NetworkManagerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+
+			id, err := networkmanagers.ParseNetworkManagerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := NetworkManagerModel{
+				Name:              id.NetworkManagerName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+
+				if props := model.Properties; props != nil {
+					state.Description = utils.NormalizeNilableString(props.Description)
+					state.Scope = flattenNetworkManagerScope(props.NetworkManagerScopes)
+					state.ScopeAccesses = flattenNetworkManagerScopeAccesses(props.NetworkManagerScopeAccesses)
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r NetworkManagerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagersClient
+
+			id, err := networkmanagers.ParseNetworkManagerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandNetworkManagerScope(input []NetworkManagerScopeModel) *networkmanagers.NetworkManagerPropertiesNetworkManagerScopes {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &networkmanagers.NetworkManagerPropertiesNetworkManagerScopes{
+		ManagementGroups: &input[0].ManagementGroupIds,
+		Subscriptions:    &input[0].SubscriptionIds,
+	}
+}
+
+func flattenNetworkManagerScope(input *networkmanagers.NetworkManagerPropertiesNetworkManagerScopes) []NetworkManagerScopeModel {
+	if input == nil {
+		return []NetworkManagerScopeModel{}
+	}
+
+	output := NetworkManagerScopeModel{
+		ManagementGroupIds: make([]string, 0),
+		SubscriptionIds:    make([]string, 0),
+	}
+	if input.ManagementGroups != nil {
+		output.ManagementGroupIds = *input.ManagementGroups
+	}
+	if input.Subscriptions != nil {
+		output.SubscriptionIds = *input.Subscriptions
+	}
+
+	return []NetworkManagerScopeModel{output}
+}
+
+func expandNetworkManagerScopeAccesses(input []string) *[]networkmanagers.ConfigurationType {
+	output := make([]networkmanagers.ConfigurationType, 0)
+	for _, v := range input {
+		output = append(output, networkmanagers.ConfigurationType(v))
+	}
+
+	return &output
+}
+
+func flattenNetworkManagerScopeAccesses(input *[]networkmanagers.ConfigurationType) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
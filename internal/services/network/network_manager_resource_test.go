@@ -0,0 +1,241 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkManagerResource struct{}
+
+func TestAccNetworkManager(t *testing.T) {
+	// NOTE: this is a combined test rather than separate split out tests due to
+	// Azure only allowing a single Network Manager per scope and scope access
+	// (which our test suite can't easily workaround)
+	testCases := map[string]map[string]func(t *testing.T){
+		"Manager": {
+			"basic":          testAccNetworkManager_basic,
+			"complete":       testAccNetworkManager_complete,
+			"update":         testAccNetworkManager_update,
+			"requiresImport": testAccNetworkManager_requiresImport,
+		},
+		"NetworkGroup": {
+			"basic":          testAccNetworkManagerNetworkGroup_basic,
+			"complete":       testAccNetworkManagerNetworkGroup_complete,
+			"update":         testAccNetworkManagerNetworkGroup_update,
+			"requiresImport": testAccNetworkManagerNetworkGroup_requiresImport,
+		},
+		"ConnectivityConfiguration": {
+			"basic":          testAccNetworkManagerConnectivityConfiguration_basic,
+			"hubAndSpoke":    testAccNetworkManagerConnectivityConfiguration_hubAndSpoke,
+			"update":         testAccNetworkManagerConnectivityConfiguration_update,
+			"requiresImport": testAccNetworkManagerConnectivityConfiguration_requiresImport,
+		},
+		"SecurityAdminConfiguration": {
+			"basic":          testAccNetworkManagerSecurityAdminConfiguration_basic,
+			"update":         testAccNetworkManagerSecurityAdminConfiguration_update,
+			"requiresImport": testAccNetworkManagerSecurityAdminConfiguration_requiresImport,
+		},
+		"AdminRuleCollection": {
+			"basic":          testAccNetworkManagerAdminRuleCollection_basic,
+			"update":         testAccNetworkManagerAdminRuleCollection_update,
+			"requiresImport": testAccNetworkManagerAdminRuleCollection_requiresImport,
+		},
+		"AdminRule": {
+			"basic":          testAccNetworkManagerAdminRule_basic,
+			"complete":       testAccNetworkManagerAdminRule_complete,
+			"update":         testAccNetworkManagerAdminRule_update,
+			"requiresImport": testAccNetworkManagerAdminRule_requiresImport,
+		},
+		"Deployment": {
+			"connectivity":   testAccNetworkManagerDeployment_connectivity,
+			"securityAdmin":  testAccNetworkManagerDeployment_securityAdmin,
+			"update":         testAccNetworkManagerDeployment_update,
+			"requiresImport": testAccNetworkManagerDeployment_requiresImport,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
+
+func testAccNetworkManager_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager", "test")
+	r := NetworkManagerResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManager_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager", "test")
+	r := NetworkManagerResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManager_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager", "test")
+	r := NetworkManagerResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("scope_accesses.#").HasValue("2"),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("scope_accesses.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManager_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager", "test")
+	r := NetworkManagerResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r NetworkManagerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := networkmanagers.ParseNetworkManagerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ManagersClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r NetworkManagerResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-nm-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r NetworkManagerResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager" "test" {
+  name                = "acctest-nm-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  scope {
+    subscription_ids = [data.azurerm_subscription.current.id]
+  }
+
+  scope_accesses = ["SecurityAdmin"]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkManagerResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager" "test" {
+  name                = "acctest-nm-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  scope {
+    subscription_ids = [data.azurerm_subscription.current.id]
+  }
+
+  scope_accesses = ["Connectivity", "SecurityAdmin"]
+  description    = "test network manager"
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkManagerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager" "import" {
+  name                = azurerm_network_manager.test.name
+  location            = azurerm_network_manager.test.location
+  resource_group_name = azurerm_network_manager.test.resource_group_name
+
+  scope {
+    subscription_ids = azurerm_network_manager.test.scope.0.subscription_ids
+  }
+
+  scope_accesses = azurerm_network_manager.test.scope_accesses
+}
+`, r.basic(data))
+}
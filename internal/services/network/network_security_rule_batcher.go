@@ -0,0 +1,280 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+var securityRuleBatcher = newNetworkSecurityRuleBatcher()
+
+type networkSecurityRuleOperation struct {
+	// ctx is the context of the submitter, which is used when this operation is applied on its own
+	ctx context.Context
+
+	name string
+
+	// rule is the Security Rule which should be created or updated - or nil when the Security Rule should be deleted
+	rule *network.SecurityRule
+
+	result chan error
+}
+
+type networkSecurityRuleBatch struct {
+	apply      func(ctx context.Context, operations []*networkSecurityRuleOperation)
+	operations []*networkSecurityRuleOperation
+}
+
+// networkSecurityRuleBatcher applies the operations against the Security Rules within the same Network Security Group
+// in a single update of the Network Security Group. An operation submitted whilst nothing is being applied to the
+// Network Security Group is applied immediately - otherwise it's collected into the next batch, which is applied as
+// soon as the current batch has been applied.
+type networkSecurityRuleBatcher struct {
+	lock sync.Mutex
+
+	// batches contains the operations which are waiting to be applied, for each key
+	batches map[string]*networkSecurityRuleBatch
+
+	// applying contains the keys which a batch is currently being applied for
+	applying map[string]bool
+}
+
+func newNetworkSecurityRuleBatcher() *networkSecurityRuleBatcher {
+	return &networkSecurityRuleBatcher{
+		batches:  make(map[string]*networkSecurityRuleBatch),
+		applying: make(map[string]bool),
+	}
+}
+
+// submit adds the operation to the pending batch for the specified key (starting a new batch if there isn't one) and
+// then waits for the batch to be applied, returning the result of this operation
+func (b *networkSecurityRuleBatcher) submit(ctx context.Context, key string, operation *networkSecurityRuleOperation, apply func(ctx context.Context, operations []*networkSecurityRuleOperation)) error {
+	operation.ctx = ctx
+
+	b.lock.Lock()
+	batch, ok := b.batches[key]
+	if !ok {
+		batch = &networkSecurityRuleBatch{
+			apply: apply,
+		}
+		b.batches[key] = batch
+	}
+	batch.operations = append(batch.operations, operation)
+	if !b.applying[key] {
+		b.applying[key] = true
+		go b.flush(key)
+	}
+	b.lock.Unlock()
+
+	select {
+	case err := <-operation.result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// flush applies the pending batches for the specified key one after another, until there are none left
+func (b *networkSecurityRuleBatcher) flush(key string) {
+	for {
+		// any operations submitted from here on are part of the next batch
+		b.lock.Lock()
+		batch, ok := b.batches[key]
+		if !ok {
+			delete(b.applying, key)
+			b.lock.Unlock()
+			return
+		}
+		delete(b.batches, key)
+		b.lock.Unlock()
+
+		// operations whose submitter has already gone away are dropped, rather than being applied
+		operations := make([]*networkSecurityRuleOperation, 0)
+		for _, operation := range batch.operations {
+			if err := operation.ctx.Err(); err != nil {
+				operation.result <- err
+				continue
+			}
+			operations = append(operations, operation)
+		}
+		if len(operations) == 0 {
+			continue
+		}
+
+		ctx, cancel := networkSecurityRuleBatchContext(operations)
+		batch.apply(ctx, operations)
+		cancel()
+	}
+}
+
+// networkSecurityRuleBatchContext returns a context for applying the batch which isn't tied to any single submitter -
+// this is bounded by the latest deadline of the submitters, and is cancelled once every submitter has gone away
+func networkSecurityRuleBatchContext(operations []*networkSecurityRuleOperation) (context.Context, context.CancelFunc) {
+	var deadline time.Time
+	for _, operation := range operations {
+		v, ok := operation.ctx.Deadline()
+		if !ok {
+			deadline = time.Time{}
+			break
+		}
+		if v.After(deadline) {
+			deadline = v
+		}
+	}
+
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if deadline.IsZero() {
+		ctx, cancel = context.WithCancel(context.Background())
+	} else {
+		ctx, cancel = context.WithDeadline(context.Background(), deadline)
+	}
+
+	go func() {
+		for _, operation := range operations {
+			select {
+			case <-operation.ctx.Done():
+			case <-ctx.Done():
+				return
+			}
+		}
+		cancel()
+	}()
+
+	return ctx, cancel
+}
+
+// submitNetworkSecurityRuleOperation creates, updates or (when rule is nil) deletes the specified Security Rule as a
+// part of a batch of operations which are applied to the Network Security Group in a single update
+func submitNetworkSecurityRuleOperation(ctx context.Context, groupsClient *network.SecurityGroupsClient, rulesClient *network.SecurityRulesClient, id parse.NetworkSecurityGroupId, name string, rule *network.SecurityRule) error {
+	operation := &networkSecurityRuleOperation{
+		name:   name,
+		rule:   rule,
+		result: make(chan error, 1),
+	}
+
+	return securityRuleBatcher.submit(ctx, strings.ToLower(id.ID()), operation, func(ctx context.Context, operations []*networkSecurityRuleOperation) {
+		applyNetworkSecurityRuleOperations(ctx, groupsClient, rulesClient, id, operations)
+	})
+}
+
+func applyNetworkSecurityRuleOperations(ctx context.Context, groupsClient *network.SecurityGroupsClient, rulesClient *network.SecurityRulesClient, id parse.NetworkSecurityGroupId, operations []*networkSecurityRuleOperation) {
+	locks.ByName(id.Name, networkSecurityGroupResourceName)
+	defer locks.UnlockByName(id.Name, networkSecurityGroupResourceName)
+
+	log.Printf("[DEBUG] Applying %d Security Rule operation(s) to %s..", len(operations), id)
+	err := updateNetworkSecurityGroupSecurityRules(ctx, groupsClient, id, operations)
+	if err == nil {
+		for _, operation := range operations {
+			operation.result <- nil
+		}
+		return
+	}
+
+	if len(operations) == 1 {
+		operations[0].result <- err
+		return
+	}
+
+	// the error can't be attributed to a specific Security Rule, so each operation is applied on its own instead, using
+	// the context of its submitter
+	log.Printf("[DEBUG] Applying the batch of Security Rule operations to %s failed, applying each operation individually: %+v", id, err)
+	for _, operation := range operations {
+		if err := operation.ctx.Err(); err != nil {
+			operation.result <- err
+			continue
+		}
+		operation.result <- applyNetworkSecurityRuleOperation(operation.ctx, rulesClient, id, operation)
+	}
+}
+
+func updateNetworkSecurityGroupSecurityRules(ctx context.Context, client *network.SecurityGroupsClient, id parse.NetworkSecurityGroupId, operations []*networkSecurityRuleOperation) error {
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.SecurityGroupPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	rules := make([]network.SecurityRule, 0)
+	if existing.SecurityGroupPropertiesFormat.SecurityRules != nil {
+		rules = *existing.SecurityGroupPropertiesFormat.SecurityRules
+	}
+	rules = mergeNetworkSecurityRuleOperations(rules, operations)
+	existing.SecurityGroupPropertiesFormat.SecurityRules = &rules
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, existing)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func applyNetworkSecurityRuleOperation(ctx context.Context, client *network.SecurityRulesClient, id parse.NetworkSecurityGroupId, operation *networkSecurityRuleOperation) error {
+	ruleId := parse.NewSecurityRuleID(id.SubscriptionId, id.ResourceGroup, id.Name, operation.name)
+
+	if operation.rule == nil {
+		future, err := client.Delete(ctx, ruleId.ResourceGroup, ruleId.NetworkSecurityGroupName, ruleId.Name)
+		if err != nil {
+			return fmt.Errorf("deleting %s: %+v", ruleId, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for the deletion of %s: %+v", ruleId, err)
+		}
+
+		return nil
+	}
+
+	future, err := client.CreateOrUpdate(ctx, ruleId.ResourceGroup, ruleId.NetworkSecurityGroupName, ruleId.Name, *operation.rule)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", ruleId, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", ruleId, err)
+	}
+
+	return nil
+}
+
+// mergeNetworkSecurityRuleOperations returns the Security Rules of the Network Security Group after the operations have
+// been applied, in the order they were submitted
+func mergeNetworkSecurityRuleOperations(existing []network.SecurityRule, operations []*networkSecurityRuleOperation) []network.SecurityRule {
+	output := make([]network.SecurityRule, 0, len(existing))
+	output = append(output, existing...)
+
+	for _, operation := range operations {
+		index := -1
+		for i, v := range output {
+			if v.Name != nil && strings.EqualFold(*v.Name, operation.name) {
+				index = i
+				break
+			}
+		}
+
+		switch {
+		case operation.rule == nil && index >= 0:
+			output = append(output[:index], output[index+1:]...)
+		case operation.rule != nil && index >= 0:
+			output[index] = *operation.rule
+		case operation.rule != nil:
+			output = append(output, *operation.rule)
+		}
+	}
+
+	return output
+}
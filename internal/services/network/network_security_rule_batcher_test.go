@@ -0,0 +1,410 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestMergeNetworkSecurityRuleOperations(t *testing.T) {
+	rule := func(name string, priority int32) network.SecurityRule {
+		return network.SecurityRule{
+			Name: utils.String(name),
+			SecurityRulePropertiesFormat: &network.SecurityRulePropertiesFormat{
+				Priority: utils.Int32(priority),
+			},
+		}
+	}
+	upsert := func(name string, priority int32) *networkSecurityRuleOperation {
+		r := rule(name, priority)
+		return &networkSecurityRuleOperation{name: name, rule: &r}
+	}
+	remove := func(name string) *networkSecurityRuleOperation {
+		return &networkSecurityRuleOperation{name: name}
+	}
+
+	testData := []struct {
+		Name       string
+		Existing   []network.SecurityRule
+		Operations []*networkSecurityRuleOperation
+		Expected   []network.SecurityRule
+	}{
+		{
+			Name:       "no operations",
+			Existing:   []network.SecurityRule{rule("first", 100)},
+			Operations: []*networkSecurityRuleOperation{},
+			Expected:   []network.SecurityRule{rule("first", 100)},
+		},
+		{
+			Name:       "create",
+			Existing:   []network.SecurityRule{rule("first", 100)},
+			Operations: []*networkSecurityRuleOperation{upsert("second", 200), upsert("third", 300)},
+			Expected:   []network.SecurityRule{rule("first", 100), rule("second", 200), rule("third", 300)},
+		},
+		{
+			Name:       "update",
+			Existing:   []network.SecurityRule{rule("first", 100), rule("second", 200)},
+			Operations: []*networkSecurityRuleOperation{upsert("FIRST", 150)},
+			Expected:   []network.SecurityRule{rule("FIRST", 150), rule("second", 200)},
+		},
+		{
+			Name:       "delete",
+			Existing:   []network.SecurityRule{rule("first", 100), rule("second", 200), rule("third", 300)},
+			Operations: []*networkSecurityRuleOperation{remove("second")},
+			Expected:   []network.SecurityRule{rule("first", 100), rule("third", 300)},
+		},
+		{
+			Name:       "delete missing",
+			Existing:   []network.SecurityRule{rule("first", 100)},
+			Operations: []*networkSecurityRuleOperation{remove("second")},
+			Expected:   []network.SecurityRule{rule("first", 100)},
+		},
+		{
+			Name:       "replace within the same batch",
+			Existing:   []network.SecurityRule{rule("first", 100)},
+			Operations: []*networkSecurityRuleOperation{remove("first"), upsert("first", 200)},
+			Expected:   []network.SecurityRule{rule("first", 200)},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		existing := make([]network.SecurityRule, len(v.Existing))
+		copy(existing, v.Existing)
+
+		actual := mergeNetworkSecurityRuleOperations(existing, v.Operations)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+		if !reflect.DeepEqual(existing, v.Existing) {
+			t.Fatalf("Expected the existing Security Rules not to be modified but got %+v", existing)
+		}
+	}
+}
+
+// blockingNetworkSecurityRuleApply returns an apply function which blocks the first batch until release is closed, so
+// that the operations submitted in the meantime are collected into the next batch
+func blockingNetworkSecurityRuleApply(applying chan<- struct{}, release <-chan struct{}, apply func(ctx context.Context, operations []*networkSecurityRuleOperation)) func(ctx context.Context, operations []*networkSecurityRuleOperation) {
+	var once sync.Once
+	return func(ctx context.Context, operations []*networkSecurityRuleOperation) {
+		first := false
+		once.Do(func() {
+			first = true
+		})
+		if first {
+			close(applying)
+			<-release
+		}
+
+		apply(ctx, operations)
+	}
+}
+
+// waitForPendingNetworkSecurityRuleOperations waits until the specified number of operations are pending for the key
+func waitForPendingNetworkSecurityRuleOperations(t *testing.T, batcher *networkSecurityRuleBatcher, key string, expected int) {
+	for i := 0; i < 500; i++ {
+		batcher.lock.Lock()
+		pending := 0
+		if batch, ok := batcher.batches[key]; ok {
+			pending = len(batch.operations)
+		}
+		batcher.lock.Unlock()
+
+		if pending == expected {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("Expected %d pending operations for %q", expected, key)
+}
+
+func TestNetworkSecurityRuleBatcherAppliesImmediately(t *testing.T) {
+	batcher := newNetworkSecurityRuleBatcher()
+
+	applied := make([]string, 0)
+	operation := &networkSecurityRuleOperation{
+		name:   "rule1",
+		result: make(chan error, 1),
+	}
+	err := batcher.submit(context.Background(), "first", operation, func(ctx context.Context, operations []*networkSecurityRuleOperation) {
+		for _, operation := range operations {
+			applied = append(applied, operation.name)
+			operation.result <- nil
+		}
+	})
+	if err != nil {
+		t.Fatalf("Expected no error but got %+v", err)
+	}
+
+	if !reflect.DeepEqual(applied, []string{"rule1"}) {
+		t.Fatalf("Expected %q to be applied but got %+v", "rule1", applied)
+	}
+}
+
+func TestNetworkSecurityRuleBatcherCoalescesOperations(t *testing.T) {
+	batcher := newNetworkSecurityRuleBatcher()
+
+	var lock sync.Mutex
+	batches := make(map[string][][]string)
+	record := func(key string) func(ctx context.Context, operations []*networkSecurityRuleOperation) {
+		return func(ctx context.Context, operations []*networkSecurityRuleOperation) {
+			lock.Lock()
+			defer lock.Unlock()
+
+			names := make([]string, 0)
+			for _, operation := range operations {
+				names = append(names, operation.name)
+				if operation.name == "failing" {
+					operation.result <- fmt.Errorf("failed")
+					continue
+				}
+				operation.result <- nil
+			}
+			batches[key] = append(batches[key], names)
+		}
+	}
+
+	// the first operation for each key is applied immediately, the remaining operations are submitted whilst it's
+	// being applied and so should be applied together in the next batch
+	names := map[string][]string{
+		"first":  {"rule0", "rule1", "rule2", "failing"},
+		"second": {"rule3", "rule4"},
+	}
+
+	var wg sync.WaitGroup
+	errors := make(map[string]error)
+	submit := func(key, name string, apply func(ctx context.Context, operations []*networkSecurityRuleOperation)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			operation := &networkSecurityRuleOperation{
+				name:   name,
+				result: make(chan error, 1),
+			}
+			err := batcher.submit(context.Background(), key, operation, apply)
+
+			lock.Lock()
+			errors[name] = err
+			lock.Unlock()
+		}()
+	}
+
+	release := make(chan struct{})
+	for key, ruleNames := range names {
+		applying := make(chan struct{})
+		apply := blockingNetworkSecurityRuleApply(applying, release, record(key))
+
+		submit(key, ruleNames[0], apply)
+		<-applying
+
+		for _, name := range ruleNames[1:] {
+			submit(key, name, apply)
+		}
+		waitForPendingNetworkSecurityRuleOperations(t, batcher, key, len(ruleNames)-1)
+	}
+	close(release)
+	wg.Wait()
+
+	for key, expected := range names {
+		expectedBatches := [][]string{expected[:1], expected[1:]}
+		if len(batches[key]) != len(expectedBatches) {
+			t.Fatalf("Expected the operations for %q to be applied in %d batches but got %+v", key, len(expectedBatches), batches[key])
+		}
+		if !reflect.DeepEqual(batches[key][0], expectedBatches[0]) {
+			t.Fatalf("Expected the first batch for %q to be %+v but got %+v", key, expectedBatches[0], batches[key][0])
+		}
+		if len(batches[key][1]) != len(expectedBatches[1]) {
+			t.Fatalf("Expected the second batch for %q to contain %d operations but got %+v", key, len(expectedBatches[1]), batches[key][1])
+		}
+	}
+
+	for name, err := range errors {
+		if name == "failing" && err == nil {
+			t.Fatalf("Expected an error for %q but didn't get one", name)
+		}
+		if name != "failing" && err != nil {
+			t.Fatalf("Expected no error for %q but got %+v", name, err)
+		}
+	}
+
+	batcher.lock.Lock()
+	defer batcher.lock.Unlock()
+	if len(batcher.batches) != 0 {
+		t.Fatalf("Expected no pending batches but got %d", len(batcher.batches))
+	}
+}
+
+func TestNetworkSecurityRuleBatcherCancelledContext(t *testing.T) {
+	batcher := newNetworkSecurityRuleBatcher()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	operation := &networkSecurityRuleOperation{
+		name:   "rule1",
+		result: make(chan error, 1),
+	}
+	err := batcher.submit(ctx, "first", operation, func(ctx context.Context, operations []*networkSecurityRuleOperation) {
+		t.Fatalf("Expected the batch not to be applied")
+	})
+	if err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+}
+
+func TestNetworkSecurityRuleBatcherSubmitterCancelledDuringApply(t *testing.T) {
+	batcher := newNetworkSecurityRuleBatcher()
+
+	applying := make(chan struct{})
+	submitterCancelled := make(chan struct{})
+	apply := func(ctx context.Context, operations []*networkSecurityRuleOperation) {
+		close(applying)
+		<-submitterCancelled
+
+		// the batch must outlive the first submitter, so the remaining operations can still be applied
+		for _, operation := range operations {
+			if err := operation.ctx.Err(); err != nil {
+				operation.result <- err
+				continue
+			}
+			operation.result <- ctx.Err()
+		}
+	}
+
+	// the operations are collected into a single batch whilst the initial operation is being applied
+	blockerApplying := make(chan struct{})
+	release := make(chan struct{})
+	blocker := &networkSecurityRuleOperation{
+		name:   "rule0",
+		result: make(chan error, 1),
+	}
+	blockerErr := make(chan error, 1)
+	go func() {
+		blockerErr <- batcher.submit(context.Background(), "first", blocker, blockingNetworkSecurityRuleApply(blockerApplying, release, func(ctx context.Context, operations []*networkSecurityRuleOperation) {
+			for _, operation := range operations {
+				operation.result <- nil
+			}
+		}))
+	}()
+	<-blockerApplying
+
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	defer cancelFirst()
+
+	names := []string{"rule1", "rule2", "rule3"}
+	errors := make([]error, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		ctx := context.Background()
+		if i == 0 {
+			ctx = firstCtx
+		}
+
+		wg.Add(1)
+		go func(ctx context.Context, i int, name string) {
+			defer wg.Done()
+
+			operation := &networkSecurityRuleOperation{
+				name:   name,
+				result: make(chan error, 1),
+			}
+			errors[i] = batcher.submit(ctx, "first", operation, apply)
+		}(ctx, i, name)
+	}
+	waitForPendingNetworkSecurityRuleOperations(t, batcher, "first", len(names))
+	close(release)
+	if err := <-blockerErr; err != nil {
+		t.Fatalf("Expected no error for %q but got %+v", blocker.name, err)
+	}
+
+	<-applying
+	cancelFirst()
+	close(submitterCancelled)
+	wg.Wait()
+
+	if errors[0] != context.Canceled {
+		t.Fatalf("Expected %q for %q but got %+v", context.Canceled, names[0], errors[0])
+	}
+	for i, err := range errors[1:] {
+		if err != nil {
+			t.Fatalf("Expected no error for %q but got %+v", names[i+1], err)
+		}
+	}
+}
+
+func TestNetworkSecurityRuleBatcherSubmitterCancelledBeforeApply(t *testing.T) {
+	batcher := newNetworkSecurityRuleBatcher()
+
+	var lock sync.Mutex
+	var applied []string
+	applying := make(chan struct{})
+	release := make(chan struct{})
+	apply := blockingNetworkSecurityRuleApply(applying, release, func(ctx context.Context, operations []*networkSecurityRuleOperation) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		for _, operation := range operations {
+			applied = append(applied, operation.name)
+			operation.result <- ctx.Err()
+		}
+	})
+
+	// the initial operation is applied immediately, so the following operations wait for the next batch
+	blocker := &networkSecurityRuleOperation{
+		name:   "rule0",
+		result: make(chan error, 1),
+	}
+	blockerErr := make(chan error, 1)
+	go func() {
+		blockerErr <- batcher.submit(context.Background(), "first", blocker, apply)
+	}()
+	<-applying
+
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	first := &networkSecurityRuleOperation{
+		name:   "rule1",
+		result: make(chan error, 1),
+	}
+	firstErr := make(chan error, 1)
+	go func() {
+		firstErr <- batcher.submit(firstCtx, "first", first, apply)
+	}()
+	waitForPendingNetworkSecurityRuleOperations(t, batcher, "first", 1)
+	cancelFirst()
+	if err := <-firstErr; err != context.Canceled {
+		t.Fatalf("Expected %q for %q but got %+v", context.Canceled, first.name, err)
+	}
+
+	second := &networkSecurityRuleOperation{
+		name:   "rule2",
+		result: make(chan error, 1),
+	}
+	secondErr := make(chan error, 1)
+	go func() {
+		secondErr <- batcher.submit(context.Background(), "first", second, apply)
+	}()
+	waitForPendingNetworkSecurityRuleOperations(t, batcher, "first", 2)
+	close(release)
+
+	if err := <-blockerErr; err != nil {
+		t.Fatalf("Expected no error for %q but got %+v", blocker.name, err)
+	}
+	if err := <-secondErr; err != nil {
+		t.Fatalf("Expected no error for %q but got %+v", second.name, err)
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	if !reflect.DeepEqual(applied, []string{"rule0", "rule2"}) {
+		t.Fatalf("Expected only %q and %q to be applied but got %+v", "rule0", "rule2", applied)
+	}
+}
@@ -204,7 +204,9 @@ func resourceNetworkSecurityRuleCreateUpdate(d *pluginsdk.ResourceData, meta int
 	direction := d.Get("direction").(string)
 	protocol := d.Get("protocol").(string)
 
-	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
+	// when operations are batched the lock is held whilst the batch is applied
+	batch := meta.(*clients.Client).Features.Network.BatchSecurityRuleOperations
+	if !batch && !meta.(*clients.Client).Features.Network.RelaxedLocking {
 		locks.ByName(nsgName, networkSecurityGroupResourceName)
 		defer locks.UnlockByName(nsgName, networkSecurityGroupResourceName)
 	}
@@ -290,13 +292,20 @@ func resourceNetworkSecurityRuleCreateUpdate(d *pluginsdk.ResourceData, meta int
 		rule.DestinationApplicationSecurityGroups = &destinationApplicationSecurityGroups
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, nsgName, name, rule)
-	if err != nil {
-		return fmt.Errorf("Creating/Updating Network Security Rule %q (NSG %q / Resource Group %q): %+v", name, nsgName, resGroup, err)
-	}
+	if batch {
+		nsgId := parse.NewNetworkSecurityGroupID(meta.(*clients.Client).Account.SubscriptionId, resGroup, nsgName)
+		if err := submitNetworkSecurityRuleOperation(ctx, meta.(*clients.Client).Network.SecurityGroupClient, client, nsgId, name, &rule); err != nil {
+			return fmt.Errorf("Creating/Updating Network Security Rule %q (NSG %q / Resource Group %q): %+v", name, nsgName, resGroup, err)
+		}
+	} else {
+		future, err := client.CreateOrUpdate(ctx, resGroup, nsgName, name, rule)
+		if err != nil {
+			return fmt.Errorf("Creating/Updating Network Security Rule %q (NSG %q / Resource Group %q): %+v", name, nsgName, resGroup, err)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for completion of Network Security Rule %q (NSG %q / Resource Group %q): %+v", name, nsgName, resGroup, err)
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for completion of Network Security Rule %q (NSG %q / Resource Group %q): %+v", name, nsgName, resGroup, err)
+		}
 	}
 
 	read, err := client.Get(ctx, resGroup, nsgName, name)
@@ -372,6 +381,15 @@ func resourceNetworkSecurityRuleDelete(d *pluginsdk.ResourceData, meta interface
 		return err
 	}
 
+	if meta.(*clients.Client).Features.Network.BatchSecurityRuleOperations {
+		nsgId := parse.NewNetworkSecurityGroupID(id.SubscriptionId, id.ResourceGroup, id.NetworkSecurityGroupName)
+		if err := submitNetworkSecurityRuleOperation(ctx, meta.(*clients.Client).Network.SecurityGroupClient, client, nsgId, id.Name, nil); err != nil {
+			return fmt.Errorf("Deleting %s: %+v", *id, err)
+		}
+
+		return nil
+	}
+
 	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
 		locks.ByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
 		defer locks.UnlockByName(id.NetworkSecurityGroupName, networkSecurityGroupResourceName)
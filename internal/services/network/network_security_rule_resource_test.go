@@ -110,6 +110,28 @@ func TestAccNetworkSecurityRule_applicationSecurityGroups(t *testing.T) {
 	})
 }
 
+func TestAccNetworkSecurityRule_batched(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_rule", "test")
+	r := NetworkSecurityRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.batched(data, 10),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("azurerm_network_security_rule.test.0").ExistsInAzure(r),
+				check.That("azurerm_network_security_rule.test.9").ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.batched(data, 5),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("azurerm_network_security_rule.test.0").ExistsInAzure(r),
+				check.That("azurerm_network_security_rule.test.4").ExistsInAzure(r),
+			),
+		},
+	})
+}
+
 func (t NetworkSecurityRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.SecurityRuleID(state.ID)
 	if err != nil {
@@ -366,3 +388,41 @@ resource "azurerm_network_security_rule" "test1" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
+
+func (NetworkSecurityRuleResource) batched(data acceptance.TestData, count int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    network {
+      batch_security_rule_operations = true
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acceptanceTestSecurityGroup1"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_network_security_rule" "test" {
+  count                       = %d
+  name                        = "test${count.index}"
+  priority                    = 100 + count.index
+  direction                   = "Outbound"
+  access                      = "Allow"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_range      = "${8000 + count.index}"
+  source_address_prefix       = "*"
+  destination_address_prefix  = "*"
+  resource_group_name         = azurerm_resource_group.test.name
+  network_security_group_name = azurerm_network_security_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, count)
+}
@@ -0,0 +1,144 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceNetworkWatcherIPFlowVerify() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkWatcherIPFlowVerifyRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"network_watcher_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NetworkWatcherID,
+			},
+
+			"target_virtual_machine_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: computeValidate.VirtualMachineID,
+			},
+
+			"direction": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.DirectionInbound),
+					string(network.DirectionOutbound),
+				}, false),
+			},
+
+			"protocol": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.IPFlowProtocolTCP),
+					string(network.IPFlowProtocolUDP),
+				}, false),
+			},
+
+			"local_ip_address": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"local_port": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"remote_ip_address": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"remote_port": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"target_network_interface_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NetworkInterfaceID,
+			},
+
+			"access": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"rule_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkWatcherIPFlowVerifyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.WatcherClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	watcherId, err := parse.NetworkWatcherID(d.Get("network_watcher_id").(string))
+	if err != nil {
+		return err
+	}
+
+	parameters := network.VerificationIPFlowParameters{
+		TargetResourceID: utils.String(d.Get("target_virtual_machine_id").(string)),
+		Direction:        network.Direction(d.Get("direction").(string)),
+		Protocol:         network.IPFlowProtocol(d.Get("protocol").(string)),
+		LocalIPAddress:   utils.String(d.Get("local_ip_address").(string)),
+		LocalPort:        utils.String(d.Get("local_port").(string)),
+		RemoteIPAddress:  utils.String(d.Get("remote_ip_address").(string)),
+		RemotePort:       utils.String(d.Get("remote_port").(string)),
+	}
+
+	if v, ok := d.GetOk("target_network_interface_id"); ok {
+		parameters.TargetNicResourceID = utils.String(v.(string))
+	}
+
+	future, err := client.VerifyIPFlow(ctx, watcherId.ResourceGroup, watcherId.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("verifying the IP Flow using %s: %+v", *watcherId, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the IP Flow verification using %s: %+v", *watcherId, err)
+	}
+
+	resp, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving the result of the IP Flow verification using %s: %+v", *watcherId, err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	d.Set("access", string(resp.Access))
+	d.Set("rule_name", resp.RuleName)
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceNetworkWatcherNextHop() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkWatcherNextHopRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"network_watcher_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NetworkWatcherID,
+			},
+
+			"target_virtual_machine_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: computeValidate.VirtualMachineID,
+			},
+
+			"source_ip_address": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"destination_ip_address": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+
+			"target_network_interface_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NetworkInterfaceID,
+			},
+
+			"next_hop_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"next_hop_ip_address": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"route_table_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkWatcherNextHopRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.WatcherClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	watcherId, err := parse.NetworkWatcherID(d.Get("network_watcher_id").(string))
+	if err != nil {
+		return err
+	}
+
+	parameters := network.NextHopParameters{
+		TargetResourceID:     utils.String(d.Get("target_virtual_machine_id").(string)),
+		SourceIPAddress:      utils.String(d.Get("source_ip_address").(string)),
+		DestinationIPAddress: utils.String(d.Get("destination_ip_address").(string)),
+	}
+
+	if v, ok := d.GetOk("target_network_interface_id"); ok {
+		parameters.TargetNicResourceID = utils.String(v.(string))
+	}
+
+	future, err := client.GetNextHop(ctx, watcherId.ResourceGroup, watcherId.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("retrieving the Next Hop from %s: %+v", *watcherId, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting to retrieve the Next Hop from %s: %+v", *watcherId, err)
+	}
+
+	resp, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving the Next Hop from %s: %+v", *watcherId, err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	d.Set("next_hop_type", string(resp.NextHopType))
+	d.Set("next_hop_ip_address", resp.NextHopIPAddress)
+	d.Set("route_table_id", resp.RouteTableID)
+
+	return nil
+}
@@ -36,6 +36,12 @@ func TestAccNetworkWatcher(t *testing.T) {
 		"DataSource": {
 			"basic": testAccDataSourceNetworkWatcher_basic,
 		},
+		"NextHopDataSource": {
+			"basic": testAccDataSourceNetworkWatcherNextHop_basic,
+		},
+		"IPFlowVerifyDataSource": {
+			"basic": testAccDataSourceNetworkWatcherIPFlowVerify_basic,
+		},
 		"PacketCaptureOld": {
 			"localDisk":                  testAccPacketCapture_localDisk,
 			"storageAccount":             testAccPacketCapture_storageAccount,
@@ -0,0 +1,76 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2021-02-01-preview/networkmanagers"
+)
+
+type NetworkManagerDeploymentId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	NetworkManagerName string
+	Location           string
+	ScopeAccess        string
+}
+
+func NewNetworkManagerDeploymentID(subscriptionId, resourceGroup, networkManagerName, location, scopeAccess string) NetworkManagerDeploymentId {
+	return NetworkManagerDeploymentId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		NetworkManagerName: networkManagerName,
+		Location:           location,
+		ScopeAccess:        scopeAccess,
+	}
+}
+
+func (id NetworkManagerDeploymentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Scope Access %q", id.ScopeAccess),
+		fmt.Sprintf("Location %q", id.Location),
+		fmt.Sprintf("Network Manager Name %q", id.NetworkManagerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Network Manager Deployment", segmentsStr)
+}
+
+func (id NetworkManagerDeploymentId) ID() string {
+	networkManagerId := networkmanagers.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName)
+	return fmt.Sprintf("%s/commit|%s|%s", networkManagerId.ID(), id.Location, id.ScopeAccess)
+}
+
+// NetworkManagerDeploymentID parses a Network Manager Deployment ID in the format
+// `{networkManagerId}/commit|{location}|{scopeAccess}` into a NetworkManagerDeploymentId struct
+func NetworkManagerDeploymentID(input string) (*NetworkManagerDeploymentId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 3 {
+		return nil, fmt.Errorf("expected an ID in the format `{networkManagerId}/commit|{location}|{scopeAccess}` but got %q", input)
+	}
+
+	if !strings.HasSuffix(segments[0], "/commit") {
+		return nil, fmt.Errorf("expected the first segment of %q to end in `/commit`", input)
+	}
+
+	networkManagerId, err := networkmanagers.ParseNetworkManagerID(strings.TrimSuffix(segments[0], "/commit"))
+	if err != nil {
+		return nil, fmt.Errorf("parsing Network Manager ID %q: %+v", segments[0], err)
+	}
+
+	if segments[1] == "" {
+		return nil, fmt.Errorf("ID was missing the `location` element")
+	}
+
+	if segments[2] == "" {
+		return nil, fmt.Errorf("ID was missing the `scopeAccess` element")
+	}
+
+	return &NetworkManagerDeploymentId{
+		SubscriptionId:     networkManagerId.SubscriptionId,
+		ResourceGroup:      networkManagerId.ResourceGroupName,
+		NetworkManagerName: networkManagerId.NetworkManagerName,
+		Location:           segments[1],
+		ScopeAccess:        segments[2],
+	}, nil
+}
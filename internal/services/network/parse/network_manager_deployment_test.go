@@ -0,0 +1,103 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestNetworkManagerDeploymentID(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Input  string
+		Error  bool
+		Expect *NetworkManagerDeploymentId
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "One Segment",
+			Input: "hello",
+			Error: true,
+		},
+		{
+			Name:  "Three Segments Invalid ID's",
+			Input: "hello|world|again",
+			Error: true,
+		},
+		{
+			Name:  "Network Manager ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkManagers/manager1",
+			Error: true,
+		},
+		{
+			Name:  "Missing Commit Suffix",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkManagers/manager1|westeurope|Connectivity",
+			Error: true,
+		},
+		{
+			Name:  "Missing Location",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkManagers/manager1/commit||Connectivity",
+			Error: true,
+		},
+		{
+			Name:  "Missing Scope Access",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkManagers/manager1/commit|westeurope|",
+			Error: true,
+		},
+		{
+			Name:  "Network Manager Deployment ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkManagers/manager1/commit|westeurope|Connectivity",
+			Error: false,
+			Expect: &NetworkManagerDeploymentId{
+				SubscriptionId:     "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:      "group1",
+				NetworkManagerName: "manager1",
+				Location:           "westeurope",
+				ScopeAccess:        "Connectivity",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := NetworkManagerDeploymentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expected an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expect.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expect.SubscriptionId, actual.SubscriptionId)
+		}
+
+		if actual.ResourceGroup != v.Expect.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for Resource Group", v.Expect.ResourceGroup, actual.ResourceGroup)
+		}
+
+		if actual.NetworkManagerName != v.Expect.NetworkManagerName {
+			t.Fatalf("Expected %q but got %q for NetworkManagerName", v.Expect.NetworkManagerName, actual.NetworkManagerName)
+		}
+
+		if actual.Location != v.Expect.Location {
+			t.Fatalf("Expected %q but got %q for Location", v.Expect.Location, actual.Location)
+		}
+
+		if actual.ScopeAccess != v.Expect.ScopeAccess {
+			t.Fatalf("Expected %q but got %q for ScopeAccess", v.Expect.ScopeAccess, actual.ScopeAccess)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected %q but got %q for ID", v.Input, actual.ID())
+		}
+	}
+}
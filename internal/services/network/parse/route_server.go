@@ -0,0 +1,69 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type RouteServerId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	VirtualHubName string
+}
+
+func NewRouteServerID(subscriptionId, resourceGroup, virtualHubName string) RouteServerId {
+	return RouteServerId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		VirtualHubName: virtualHubName,
+	}
+}
+
+func (id RouteServerId) String() string {
+	segments := []string{
+		fmt.Sprintf("Virtual Hub Name %q", id.VirtualHubName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Route Server", segmentsStr)
+}
+
+func (id RouteServerId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualHubs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualHubName)
+}
+
+// RouteServerID parses a RouteServer ID into an RouteServerId struct
+func RouteServerID(input string) (*RouteServerId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := RouteServerId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VirtualHubName, err = id.PopSegment("virtualHubs"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -1,6 +1,7 @@
 package network
 
 import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -21,33 +22,37 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_application_gateway":                       dataSourceApplicationGateway(),
-		"azurerm_application_security_group":                dataSourceApplicationSecurityGroup(),
-		"azurerm_express_route_circuit":                     dataSourceExpressRouteCircuit(),
-		"azurerm_ip_group":                                  dataSourceIpGroup(),
-		"azurerm_nat_gateway":                               dataSourceNatGateway(),
-		"azurerm_network_ddos_protection_plan":              dataSourceNetworkDDoSProtectionPlan(),
-		"azurerm_network_interface":                         dataSourceNetworkInterface(),
-		"azurerm_network_security_group":                    dataSourceNetworkSecurityGroup(),
-		"azurerm_network_watcher":                           dataSourceNetworkWatcher(),
-		"azurerm_private_endpoint_connection":               dataSourcePrivateEndpointConnection(),
-		"azurerm_private_link_service":                      dataSourcePrivateLinkService(),
-		"azurerm_private_link_service_endpoint_connections": dataSourcePrivateLinkServiceEndpointConnections(),
-		"azurerm_public_ip":                                 dataSourcePublicIP(),
-		"azurerm_public_ips":                                dataSourcePublicIPs(),
-		"azurerm_public_ip_prefix":                          dataSourcePublicIpPrefix(),
-		"azurerm_route_filter":                              dataSourceRouteFilter(),
-		"azurerm_route_table":                               dataSourceRouteTable(),
-		"azurerm_network_service_tags":                      dataSourceNetworkServiceTags(),
-		"azurerm_subnet":                                    dataSourceSubnet(),
-		"azurerm_virtual_hub":                               dataSourceVirtualHub(),
-		"azurerm_virtual_network_gateway":                   dataSourceVirtualNetworkGateway(),
-		"azurerm_virtual_network_gateway_connection":        dataSourceVirtualNetworkGatewayConnection(),
-		"azurerm_virtual_network":                           dataSourceVirtualNetwork(),
-		"azurerm_web_application_firewall_policy":           dataWebApplicationFirewallPolicy(),
-		"azurerm_virtual_wan":                               dataSourceVirtualWan(),
-		"azurerm_local_network_gateway":                     dataSourceLocalNetworkGateway(),
-		"azurerm_vpn_gateway":                               dataSourceVPNGateway(),
+		"azurerm_application_gateway":                        dataSourceApplicationGateway(),
+		"azurerm_application_security_group":                 dataSourceApplicationSecurityGroup(),
+		"azurerm_express_route_circuit":                      dataSourceExpressRouteCircuit(),
+		"azurerm_ip_group":                                   dataSourceIpGroup(),
+		"azurerm_nat_gateway":                                dataSourceNatGateway(),
+		"azurerm_network_ddos_protection_plan":               dataSourceNetworkDDoSProtectionPlan(),
+		"azurerm_network_interface":                          dataSourceNetworkInterface(),
+		"azurerm_network_interface_effective_routes":         dataSourceNetworkInterfaceEffectiveRoutes(),
+		"azurerm_network_interface_effective_security_rules": dataSourceNetworkInterfaceEffectiveSecurityRules(),
+		"azurerm_network_security_group":                     dataSourceNetworkSecurityGroup(),
+		"azurerm_network_watcher":                            dataSourceNetworkWatcher(),
+		"azurerm_network_watcher_ip_flow_verify":             dataSourceNetworkWatcherIPFlowVerify(),
+		"azurerm_network_watcher_next_hop":                   dataSourceNetworkWatcherNextHop(),
+		"azurerm_private_endpoint_connection":                dataSourcePrivateEndpointConnection(),
+		"azurerm_private_link_service":                       dataSourcePrivateLinkService(),
+		"azurerm_private_link_service_endpoint_connections":  dataSourcePrivateLinkServiceEndpointConnections(),
+		"azurerm_public_ip":                                  dataSourcePublicIP(),
+		"azurerm_public_ips":                                 dataSourcePublicIPs(),
+		"azurerm_public_ip_prefix":                           dataSourcePublicIpPrefix(),
+		"azurerm_route_filter":                               dataSourceRouteFilter(),
+		"azurerm_route_table":                                dataSourceRouteTable(),
+		"azurerm_network_service_tags":                       dataSourceNetworkServiceTags(),
+		"azurerm_subnet":                                     dataSourceSubnet(),
+		"azurerm_virtual_hub":                                dataSourceVirtualHub(),
+		"azurerm_virtual_network_gateway":                    dataSourceVirtualNetworkGateway(),
+		"azurerm_virtual_network_gateway_connection":         dataSourceVirtualNetworkGatewayConnection(),
+		"azurerm_virtual_network":                            dataSourceVirtualNetwork(),
+		"azurerm_web_application_firewall_policy":            dataWebApplicationFirewallPolicy(),
+		"azurerm_virtual_wan":                                dataSourceVirtualWan(),
+		"azurerm_local_network_gateway":                      dataSourceLocalNetworkGateway(),
+		"azurerm_vpn_gateway":                                dataSourceVPNGateway(),
 	}
 }
 
@@ -55,6 +60,11 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_application_gateway":                      resourceApplicationGateway(),
+		"azurerm_application_gateway_backend_address_pool": resourceApplicationGatewayBackendAddressPool(),
+		"azurerm_application_gateway_http_listener":        resourceApplicationGatewayHTTPListener(),
+		"azurerm_application_gateway_probe":                resourceApplicationGatewayProbe(),
+		"azurerm_application_gateway_request_routing_rule": resourceApplicationGatewayRequestRoutingRule(),
+		"azurerm_application_gateway_ssl_certificate":      resourceApplicationGatewaySslCertificate(),
 		"azurerm_application_security_group":               resourceApplicationSecurityGroup(),
 		"azurerm_bastion_host":                             resourceBastionHost(),
 		"azurerm_express_route_circuit_connection":         resourceExpressRouteCircuitConnection(),
@@ -94,6 +104,8 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_route_filter":                              resourceRouteFilter(),
 		"azurerm_route_table":                               resourceRouteTable(),
 		"azurerm_route":                                     resourceRoute(),
+		"azurerm_route_server":                              resourceRouteServer(),
+		"azurerm_route_server_bgp_connection":               resourceRouteServerBgpConnection(),
 		"azurerm_virtual_hub_security_partner_provider":     resourceVirtualHubSecurityPartnerProvider(),
 		"azurerm_subnet_service_endpoint_storage_policy":    resourceSubnetServiceEndpointStoragePolicy(),
 		"azurerm_subnet_network_security_group_association": resourceSubnetNetworkSecurityGroupAssociation(),
@@ -109,13 +121,33 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_virtual_network_dns_servers":               resourceVirtualNetworkDnsServers(),
 		"azurerm_virtual_network_gateway_connection":        resourceVirtualNetworkGatewayConnection(),
 		"azurerm_virtual_network_gateway":                   resourceVirtualNetworkGateway(),
+		"azurerm_virtual_network_gateway_nat_rule":          resourceVirtualNetworkGatewayNatRule(),
 		"azurerm_virtual_network_peering":                   resourceVirtualNetworkPeering(),
 		"azurerm_virtual_network":                           resourceVirtualNetwork(),
 		"azurerm_virtual_wan":                               resourceVirtualWan(),
 		"azurerm_vpn_gateway":                               resourceVPNGateway(),
 		"azurerm_vpn_gateway_connection":                    resourceVPNGatewayConnection(),
+		"azurerm_vpn_gateway_nat_rule":                      resourceVPNGatewayNatRule(),
 		"azurerm_vpn_server_configuration":                  resourceVPNServerConfiguration(),
 		"azurerm_vpn_site":                                  resourceVpnSite(),
 		"azurerm_web_application_firewall_policy":           resourceWebApplicationFirewallPolicy(),
 	}
 }
+
+// DataSources returns a list of Data Sources supported by this Service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+// Resources returns a list of Resources supported by this Service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		NetworkManagerResource{},
+		NetworkManagerAdminRuleResource{},
+		NetworkManagerAdminRuleCollectionResource{},
+		NetworkManagerConnectivityConfigurationResource{},
+		NetworkManagerDeploymentResource{},
+		NetworkManagerNetworkGroupResource{},
+		NetworkManagerSecurityAdminConfigurationResource{},
+	}
+}
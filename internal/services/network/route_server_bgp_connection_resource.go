@@ -0,0 +1,172 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceRouteServerBgpConnection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceRouteServerBgpConnectionCreate,
+		Read:   resourceRouteServerBgpConnectionRead,
+		Delete: resourceRouteServerBgpConnectionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.RouteServerBgpConnectionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"route_server_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RouteServerID,
+			},
+
+			"peer_asn": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"peer_ip": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+		},
+	}
+}
+
+func resourceRouteServerBgpConnectionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualHubBgpConnectionClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	routeServerId, err := parse.RouteServerID(d.Get("route_server_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewRouteServerBgpConnectionID(routeServerId.SubscriptionId, routeServerId.ResourceGroup, routeServerId.VirtualHubName, d.Get("name").(string))
+
+	locks.ByName(id.VirtualHubName, routeServerResourceName)
+	defer locks.UnlockByName(id.VirtualHubName, routeServerResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.BgpConnectionName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if existing.ID != nil && *existing.ID != "" {
+		return tf.ImportAsExistsError("azurerm_route_server_bgp_connection", id.ID())
+	}
+
+	parameters := network.BgpConnection{
+		Name: utils.String(id.BgpConnectionName),
+		BgpConnectionProperties: &network.BgpConnectionProperties{
+			PeerAsn: utils.Int64(int64(d.Get("peer_asn").(int))),
+			PeerIP:  utils.String(d.Get("peer_ip").(string)),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualHubName, id.BgpConnectionName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceRouteServerBgpConnectionRead(d, meta)
+}
+
+func resourceRouteServerBgpConnectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualHubBgpConnectionClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RouteServerBgpConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.BgpConnectionName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.BgpConnectionName)
+	d.Set("route_server_id", parse.NewRouteServerID(id.SubscriptionId, id.ResourceGroup, id.VirtualHubName).ID())
+
+	if props := resp.BgpConnectionProperties; props != nil {
+		d.Set("peer_asn", props.PeerAsn)
+		d.Set("peer_ip", props.PeerIP)
+	}
+
+	return nil
+}
+
+func resourceRouteServerBgpConnectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualHubBgpConnectionClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RouteServerBgpConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.VirtualHubName, routeServerResourceName)
+	defer locks.UnlockByName(id.VirtualHubName, routeServerResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualHubName, id.BgpConnectionName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,8 @@
+package adminrulecollections
+
+type RuleCollectionPropertiesFormat struct {
+	AppliesToGroups   *[]NetworkManagerSecurityGroupItem `json:"appliesToGroups,omitempty"`
+	Description       *string                            `json:"description,omitempty"`
+	DisplayName       *string                            `json:"displayName,omitempty"`
+	ProvisioningState *ProvisioningState                 `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,9 @@
+package adminrulecollections
+
+import "fmt"
+
+const defaultApiVersion = "2021-02-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/adminrulecollections/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package adminrules
+
+type AdminPropertiesFormat struct {
+	Access                SecurityConfigurationRuleAccess    `json:"access"`
+	Description           *string                            `json:"description,omitempty"`
+	DestinationPortRanges *[]string                          `json:"destinationPortRanges,omitempty"`
+	Destinations          *[]AddressPrefixItem               `json:"destinations,omitempty"`
+	Direction             SecurityConfigurationRuleDirection `json:"direction"`
+	DisplayName           *string                            `json:"displayName,omitempty"`
+	Priority              *int64                             `json:"priority,omitempty"`
+	Protocol              SecurityConfigurationRuleProtocol  `json:"protocol"`
+	ProvisioningState     *ProvisioningState                 `json:"provisioningState,omitempty"`
+	SourcePortRanges      *[]string                          `json:"sourcePortRanges,omitempty"`
+	Sources               *[]AddressPrefixItem               `json:"sources,omitempty"`
+}
@@ -0,0 +1,9 @@
+package adminrules
+
+import "fmt"
+
+const defaultApiVersion = "2021-02-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/adminrules/%s", defaultApiVersion)
+}
@@ -0,0 +1,12 @@
+package connectivityconfigurations
+
+type ConnectivityConfigurationProperties struct {
+	AppliesToGroups       *[]ConnectivityGroupItem `json:"appliesToGroups,omitempty"`
+	ConnectivityTopology  ConnectivityTopology     `json:"connectivityTopology"`
+	DeleteExistingPeering *DeleteExistingPeering   `json:"deleteExistingPeering,omitempty"`
+	Description           *string                  `json:"description,omitempty"`
+	DisplayName           *string                  `json:"displayName,omitempty"`
+	Hubs                  *[]Hub                   `json:"hubs,omitempty"`
+	IsGlobal              *IsGlobal                `json:"isGlobal,omitempty"`
+	ProvisioningState     *ProvisioningState       `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,8 @@
+package connectivityconfigurations
+
+type ConnectivityGroupItem struct {
+	GroupConnectivity *GroupConnectivity `json:"groupConnectivity,omitempty"`
+	IsGlobal          *IsGlobal          `json:"isGlobal,omitempty"`
+	NetworkGroupId    *string            `json:"networkGroupId,omitempty"`
+	UseHubGateway     *UseHubGateway     `json:"useHubGateway,omitempty"`
+}
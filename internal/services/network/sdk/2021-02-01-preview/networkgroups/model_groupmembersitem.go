@@ -0,0 +1,5 @@
+package networkgroups
+
+type GroupMembersItem struct {
+	ResourceId *string `json:"resourceId,omitempty"`
+}
@@ -0,0 +1,10 @@
+package networkgroups
+
+type NetworkGroup struct {
+	Etag       *string                 `json:"etag,omitempty"`
+	Id         *string                 `json:"id,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Properties *NetworkGroupProperties `json:"properties,omitempty"`
+	SystemData *SystemData             `json:"systemData,omitempty"`
+	Type       *string                 `json:"type,omitempty"`
+}
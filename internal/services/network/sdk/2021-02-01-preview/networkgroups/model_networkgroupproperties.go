@@ -0,0 +1,10 @@
+package networkgroups
+
+type NetworkGroupProperties struct {
+	ConditionalMembership *string             `json:"conditionalMembership,omitempty"`
+	Description           *string             `json:"description,omitempty"`
+	DisplayName           *string             `json:"displayName,omitempty"`
+	GroupMembers          *[]GroupMembersItem `json:"groupMembers,omitempty"`
+	MemberType            *MemberType         `json:"memberType,omitempty"`
+	ProvisioningState     *ProvisioningState  `json:"provisioningState,omitempty"`
+}
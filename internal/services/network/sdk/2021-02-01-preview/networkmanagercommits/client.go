@@ -0,0 +1,15 @@
+package networkmanagercommits
+
+import "github.com/Azure/go-autorest/autorest"
+
+type NetworkManagerCommitsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNetworkManagerCommitsClientWithBaseURI(endpoint string) NetworkManagerCommitsClient {
+	return NetworkManagerCommitsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
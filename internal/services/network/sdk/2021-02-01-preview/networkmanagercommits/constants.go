@@ -0,0 +1,34 @@
+package networkmanagercommits
+
+import "strings"
+
+type ConfigurationType string
+
+const (
+	ConfigurationTypeConnectivity  ConfigurationType = "Connectivity"
+	ConfigurationTypeSecurityAdmin ConfigurationType = "SecurityAdmin"
+	ConfigurationTypeSecurityUser  ConfigurationType = "SecurityUser"
+)
+
+func PossibleValuesForConfigurationType() []string {
+	return []string{
+		string(ConfigurationTypeConnectivity),
+		string(ConfigurationTypeSecurityAdmin),
+		string(ConfigurationTypeSecurityUser),
+	}
+}
+
+func parseConfigurationType(input string) (*ConfigurationType, error) {
+	vals := map[string]ConfigurationType{
+		"connectivity":  ConfigurationTypeConnectivity,
+		"securityadmin": ConfigurationTypeSecurityAdmin,
+		"securityuser":  ConfigurationTypeSecurityUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ConfigurationType(input)
+	return &out, nil
+}
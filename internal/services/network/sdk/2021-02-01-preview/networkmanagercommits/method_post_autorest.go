@@ -0,0 +1,66 @@
+package networkmanagercommits
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type PostResponse struct {
+	HttpResponse *http.Response
+	Model        *NetworkManagerCommit
+}
+
+// Post ...
+func (c NetworkManagerCommitsClient) Post(ctx context.Context, id NetworkManagerId, input NetworkManagerCommit) (result PostResponse, err error) {
+	req, err := c.preparerForPost(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagercommits.NetworkManagerCommitsClient", "Post", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagercommits.NetworkManagerCommitsClient", "Post", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForPost(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagercommits.NetworkManagerCommitsClient", "Post", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForPost prepares the Post request.
+func (c NetworkManagerCommitsClient) preparerForPost(ctx context.Context, id NetworkManagerId, input NetworkManagerCommit) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/commit", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForPost handles the response to the Post request. The method always
+// closes the http.Response Body.
+func (c NetworkManagerCommitsClient) responderForPost(resp *http.Response) (result PostResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,8 @@
+package networkmanagercommits
+
+type NetworkManagerCommit struct {
+	CommitId         *string            `json:"commitId,omitempty"`
+	CommitType       *ConfigurationType `json:"commitType,omitempty"`
+	ConfigurationIds *[]string          `json:"configurationIds,omitempty"`
+	TargetLocations  *[]string          `json:"targetLocations,omitempty"`
+}
@@ -0,0 +1,68 @@
+package networkmanagerdeploymentstatus
+
+import "strings"
+
+type ConfigurationType string
+
+const (
+	ConfigurationTypeConnectivity  ConfigurationType = "Connectivity"
+	ConfigurationTypeSecurityAdmin ConfigurationType = "SecurityAdmin"
+	ConfigurationTypeSecurityUser  ConfigurationType = "SecurityUser"
+)
+
+func PossibleValuesForConfigurationType() []string {
+	return []string{
+		string(ConfigurationTypeConnectivity),
+		string(ConfigurationTypeSecurityAdmin),
+		string(ConfigurationTypeSecurityUser),
+	}
+}
+
+func parseConfigurationType(input string) (*ConfigurationType, error) {
+	vals := map[string]ConfigurationType{
+		"connectivity":  ConfigurationTypeConnectivity,
+		"securityadmin": ConfigurationTypeSecurityAdmin,
+		"securityuser":  ConfigurationTypeSecurityUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ConfigurationType(input)
+	return &out, nil
+}
+
+type DeploymentStatus string
+
+const (
+	DeploymentStatusDeployed   DeploymentStatus = "Deployed"
+	DeploymentStatusDeploying  DeploymentStatus = "Deploying"
+	DeploymentStatusFailed     DeploymentStatus = "Failed"
+	DeploymentStatusNotStarted DeploymentStatus = "NotStarted"
+)
+
+func PossibleValuesForDeploymentStatus() []string {
+	return []string{
+		string(DeploymentStatusDeployed),
+		string(DeploymentStatusDeploying),
+		string(DeploymentStatusFailed),
+		string(DeploymentStatusNotStarted),
+	}
+}
+
+func parseDeploymentStatus(input string) (*DeploymentStatus, error) {
+	vals := map[string]DeploymentStatus{
+		"deployed":   DeploymentStatusDeployed,
+		"deploying":  DeploymentStatusDeploying,
+		"failed":     DeploymentStatusFailed,
+		"notstarted": DeploymentStatusNotStarted,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DeploymentStatus(input)
+	return &out, nil
+}
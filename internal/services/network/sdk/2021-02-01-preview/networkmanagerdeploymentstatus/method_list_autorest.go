@@ -0,0 +1,66 @@
+package networkmanagerdeploymentstatus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ListResponse struct {
+	HttpResponse *http.Response
+	Model        *NetworkManagerDeploymentStatusListResult
+}
+
+// List ...
+func (c NetworkManagerDeploymentStatusClient) List(ctx context.Context, id NetworkManagerId, input NetworkManagerDeploymentStatusParameter) (result ListResponse, err error) {
+	req, err := c.preparerForList(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagerdeploymentstatus.NetworkManagerDeploymentStatusClient", "List", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagerdeploymentstatus.NetworkManagerDeploymentStatusClient", "List", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForList(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkmanagerdeploymentstatus.NetworkManagerDeploymentStatusClient", "List", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForList prepares the List request.
+func (c NetworkManagerDeploymentStatusClient) preparerForList(ctx context.Context, id NetworkManagerId, input NetworkManagerDeploymentStatusParameter) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/listDeploymentStatus", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForList handles the response to the List request. The method always
+// closes the http.Response Body.
+func (c NetworkManagerDeploymentStatusClient) responderForList(resp *http.Response) (result ListResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,6 @@
+package networkmanagerdeploymentstatus
+
+type NetworkManagerDeploymentStatusListResult struct {
+	SkipToken *string                           `json:"skipToken,omitempty"`
+	Value     *[]NetworkManagerDeploymentStatus `json:"value,omitempty"`
+}
@@ -0,0 +1,9 @@
+package networkmanagerdeploymentstatus
+
+import "fmt"
+
+const defaultApiVersion = "2021-02-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/networkmanagerdeploymentstatus/%s", defaultApiVersion)
+}
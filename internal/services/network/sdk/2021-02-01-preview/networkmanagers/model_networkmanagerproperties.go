@@ -0,0 +1,9 @@
+package networkmanagers
+
+type NetworkManagerProperties struct {
+	Description                 *string                                       `json:"description,omitempty"`
+	DisplayName                 *string                                       `json:"displayName,omitempty"`
+	NetworkManagerScopeAccesses *[]ConfigurationType                          `json:"networkManagerScopeAccesses,omitempty"`
+	NetworkManagerScopes        *NetworkManagerPropertiesNetworkManagerScopes `json:"networkManagerScopes,omitempty"`
+	ProvisioningState           *ProvisioningState                            `json:"provisioningState,omitempty"`
+}
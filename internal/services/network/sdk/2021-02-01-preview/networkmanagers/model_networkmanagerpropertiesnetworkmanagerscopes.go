@@ -0,0 +1,6 @@
+package networkmanagers
+
+type NetworkManagerPropertiesNetworkManagerScopes struct {
+	ManagementGroups *[]string `json:"managementGroups,omitempty"`
+	Subscriptions    *[]string `json:"subscriptions,omitempty"`
+}
@@ -0,0 +1,127 @@
+package securityadminconfigurations
+
+import "strings"
+
+type CreatedByType string
+
+const (
+	CreatedByTypeApplication     CreatedByType = "Application"
+	CreatedByTypeKey             CreatedByType = "Key"
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	CreatedByTypeUser            CreatedByType = "User"
+)
+
+func PossibleValuesForCreatedByType() []string {
+	return []string{
+		string(CreatedByTypeApplication),
+		string(CreatedByTypeKey),
+		string(CreatedByTypeManagedIdentity),
+		string(CreatedByTypeUser),
+	}
+}
+
+func parseCreatedByType(input string) (*CreatedByType, error) {
+	vals := map[string]CreatedByType{
+		"application":     CreatedByTypeApplication,
+		"key":             CreatedByTypeKey,
+		"managedidentity": CreatedByTypeManagedIdentity,
+		"user":            CreatedByTypeUser,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CreatedByType(input)
+	return &out, nil
+}
+
+type DeleteExistingNSGs string
+
+const (
+	DeleteExistingNSGsFalse DeleteExistingNSGs = "False"
+	DeleteExistingNSGsTrue  DeleteExistingNSGs = "True"
+)
+
+func PossibleValuesForDeleteExistingNSGs() []string {
+	return []string{
+		string(DeleteExistingNSGsFalse),
+		string(DeleteExistingNSGsTrue),
+	}
+}
+
+func parseDeleteExistingNSGs(input string) (*DeleteExistingNSGs, error) {
+	vals := map[string]DeleteExistingNSGs{
+		"false": DeleteExistingNSGsFalse,
+		"true":  DeleteExistingNSGsTrue,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DeleteExistingNSGs(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type SecurityType string
+
+const (
+	SecurityTypeAdminPolicy SecurityType = "AdminPolicy"
+	SecurityTypeUserPolicy  SecurityType = "UserPolicy"
+)
+
+func PossibleValuesForSecurityType() []string {
+	return []string{
+		string(SecurityTypeAdminPolicy),
+		string(SecurityTypeUserPolicy),
+	}
+}
+
+func parseSecurityType(input string) (*SecurityType, error) {
+	vals := map[string]SecurityType{
+		"adminpolicy": SecurityTypeAdminPolicy,
+		"userpolicy":  SecurityTypeUserPolicy,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SecurityType(input)
+	return &out, nil
+}
@@ -0,0 +1,9 @@
+package securityadminconfigurations
+
+type SecurityConfigurationPropertiesFormat struct {
+	DeleteExistingNSGs *DeleteExistingNSGs `json:"deleteExistingNSGs,omitempty"`
+	Description        *string             `json:"description,omitempty"`
+	DisplayName        *string             `json:"displayName,omitempty"`
+	ProvisioningState  *ProvisioningState  `json:"provisioningState,omitempty"`
+	SecurityType       *SecurityType       `json:"securityType,omitempty"`
+}
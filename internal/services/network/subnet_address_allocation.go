@@ -0,0 +1,133 @@
+package network
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+)
+
+type subnetAddressRange struct {
+	start uint32
+	end   uint32
+}
+
+// allocateSubnetAddressPrefix returns the first IPv4 range of the specified prefix length within the
+// address space of the Virtual Network which doesn't overlap any of the existing address prefixes
+func allocateSubnetAddressPrefix(addressSpaces []string, existingPrefixes []string, prefixLength int) (*string, error) {
+	used := make([]subnetAddressRange, 0)
+	for _, prefix := range existingPrefixes {
+		r, err := parseSubnetAddressRange(prefix)
+		if err != nil {
+			return nil, err
+		}
+		// IPv6 ranges can't overlap an IPv4 range, so can be ignored
+		if r != nil {
+			used = append(used, *r)
+		}
+	}
+	sort.Slice(used, func(i, j int) bool {
+		return used[i].start < used[j].start
+	})
+
+	size := uint64(1) << uint(32-prefixLength)
+	for _, addressSpace := range addressSpaces {
+		space, err := parseSubnetAddressRange(addressSpace)
+		if err != nil {
+			return nil, err
+		}
+		if space == nil {
+			continue
+		}
+
+		// the address space is too small to hold a range of this size
+		if uint64(space.end)-uint64(space.start)+1 < size {
+			continue
+		}
+
+		candidate := uint64(space.start)
+		for candidate+size-1 <= uint64(space.end) {
+			overlapping := findOverlappingSubnetAddressRange(used, candidate, candidate+size-1)
+			if overlapping == nil {
+				ip := make(net.IP, net.IPv4len)
+				binary.BigEndian.PutUint32(ip, uint32(candidate))
+				output := fmt.Sprintf("%s/%d", ip.String(), prefixLength)
+				return &output, nil
+			}
+
+			// move to the first aligned range after the overlapping range
+			next := uint64(overlapping.end) + 1
+			candidate = (next + size - 1) / size * size
+		}
+	}
+
+	return nil, fmt.Errorf("no free address range with a prefix length of /%d was found within the address space %v", prefixLength, addressSpaces)
+}
+
+func findOverlappingSubnetAddressRange(used []subnetAddressRange, start, end uint64) *subnetAddressRange {
+	for i, r := range used {
+		if uint64(r.start) <= end && start <= uint64(r.end) {
+			return &used[i]
+		}
+	}
+
+	return nil
+}
+
+// parseSubnetAddressRange parses an IPv4 CIDR into the first and last addresses of the range - returning nil for IPv6 CIDRs
+func parseSubnetAddressRange(input string) (*subnetAddressRange, error) {
+	_, ipNet, err := net.ParseCIDR(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a CIDR: %+v", input, err)
+	}
+
+	ip := ipNet.IP.To4()
+	if ip == nil {
+		return nil, nil
+	}
+
+	ones, bits := ipNet.Mask.Size()
+	start := binary.BigEndian.Uint32(ip)
+	end := start + uint32((uint64(1)<<uint(bits-ones))-1)
+
+	return &subnetAddressRange{
+		start: start,
+		end:   end,
+	}, nil
+}
+
+// subnetAddressPrefixesFromVirtualNetwork returns the address spaces of the Virtual Network and the address
+// prefixes currently used by Subnets within it
+func subnetAddressPrefixesFromVirtualNetwork(vnet network.VirtualNetwork) ([]string, []string) {
+	addressSpaces := make([]string, 0)
+	existingPrefixes := make([]string, 0)
+
+	props := vnet.VirtualNetworkPropertiesFormat
+	if props == nil {
+		return addressSpaces, existingPrefixes
+	}
+
+	if props.AddressSpace != nil && props.AddressSpace.AddressPrefixes != nil {
+		addressSpaces = append(addressSpaces, *props.AddressSpace.AddressPrefixes...)
+	}
+
+	if props.Subnets != nil {
+		for _, subnet := range *props.Subnets {
+			if subnet.SubnetPropertiesFormat == nil {
+				continue
+			}
+
+			if subnet.SubnetPropertiesFormat.AddressPrefix != nil {
+				existingPrefixes = append(existingPrefixes, *subnet.SubnetPropertiesFormat.AddressPrefix)
+			}
+
+			if subnet.SubnetPropertiesFormat.AddressPrefixes != nil {
+				existingPrefixes = append(existingPrefixes, *subnet.SubnetPropertiesFormat.AddressPrefixes...)
+			}
+		}
+	}
+
+	return addressSpaces, existingPrefixes
+}
@@ -0,0 +1,132 @@
+package network
+
+import (
+	"testing"
+)
+
+func TestAllocateSubnetAddressPrefix(t *testing.T) {
+	testData := []struct {
+		Name             string
+		AddressSpaces    []string
+		ExistingPrefixes []string
+		PrefixLength     int
+		Expected         string
+		Error            bool
+	}{
+		{
+			Name:          "empty address space",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			PrefixLength:  24,
+			Expected:      "10.0.0.0/24",
+		},
+		{
+			Name:             "first range in use",
+			AddressSpaces:    []string{"10.0.0.0/16"},
+			ExistingPrefixes: []string{"10.0.0.0/24"},
+			PrefixLength:     24,
+			Expected:         "10.0.1.0/24",
+		},
+		{
+			Name:             "gap between existing ranges",
+			AddressSpaces:    []string{"10.0.0.0/16"},
+			ExistingPrefixes: []string{"10.0.2.0/24", "10.0.0.0/24"},
+			PrefixLength:     24,
+			Expected:         "10.0.1.0/24",
+		},
+		{
+			Name:             "gap too small for the requested range",
+			AddressSpaces:    []string{"10.0.0.0/16"},
+			ExistingPrefixes: []string{"10.0.0.0/26", "10.0.0.128/26"},
+			PrefixLength:     25,
+			Expected:         "10.0.1.0/25",
+		},
+		{
+			Name:             "smaller range fits in a gap",
+			AddressSpaces:    []string{"10.0.0.0/16"},
+			ExistingPrefixes: []string{"10.0.0.0/26", "10.0.0.128/26"},
+			PrefixLength:     26,
+			Expected:         "10.0.0.64/26",
+		},
+		{
+			Name:             "aligned after an unaligned existing range",
+			AddressSpaces:    []string{"10.0.0.0/16"},
+			ExistingPrefixes: []string{"10.0.0.0/28", "10.0.0.16/29"},
+			PrefixLength:     27,
+			Expected:         "10.0.0.32/27",
+		},
+		{
+			Name:             "first address space full",
+			AddressSpaces:    []string{"10.0.0.0/24", "10.1.0.0/16"},
+			ExistingPrefixes: []string{"10.0.0.0/25", "10.0.0.128/25"},
+			PrefixLength:     26,
+			Expected:         "10.1.0.0/26",
+		},
+		{
+			Name:          "address space too small",
+			AddressSpaces: []string{"10.0.0.0/24", "10.1.0.0/16"},
+			PrefixLength:  22,
+			Expected:      "10.1.0.0/22",
+		},
+		{
+			Name:             "ipv6 ranges are ignored",
+			AddressSpaces:    []string{"ace:cab:deca::/48", "10.0.0.0/16"},
+			ExistingPrefixes: []string{"ace:cab:deca:deed::/64"},
+			PrefixLength:     24,
+			Expected:         "10.0.0.0/24",
+		},
+		{
+			Name:             "existing range larger than the requested range",
+			AddressSpaces:    []string{"10.0.0.0/16"},
+			ExistingPrefixes: []string{"10.0.0.0/17"},
+			PrefixLength:     29,
+			Expected:         "10.0.128.0/29",
+		},
+		{
+			Name:             "last range of the address space",
+			AddressSpaces:    []string{"10.0.0.0/24"},
+			ExistingPrefixes: []string{"10.0.0.0/25", "10.0.0.128/26"},
+			PrefixLength:     26,
+			Expected:         "10.0.0.192/26",
+		},
+		{
+			Name:             "address space exhausted",
+			AddressSpaces:    []string{"10.0.0.0/24"},
+			ExistingPrefixes: []string{"10.0.0.0/25", "10.0.0.128/26", "10.0.0.224/27"},
+			PrefixLength:     26,
+			Error:            true,
+		},
+		{
+			Name:          "top of the ipv4 address space",
+			AddressSpaces: []string{"255.255.255.0/24"},
+			PrefixLength:  29,
+			Expected:      "255.255.255.0/29",
+		},
+		{
+			Name:             "invalid existing prefix",
+			AddressSpaces:    []string{"10.0.0.0/16"},
+			ExistingPrefixes: []string{"10.0.0.0"},
+			PrefixLength:     24,
+			Error:            true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := allocateSubnetAddressPrefix(v.AddressSpaces, v.ExistingPrefixes, v.PrefixLength)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("Expected an error but got %q", *actual)
+		}
+
+		if *actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, *actual)
+		}
+	}
+}
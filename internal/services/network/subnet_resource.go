@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"strings"
 	"time"
 
@@ -61,7 +62,7 @@ func resourceSubnet() *pluginsdk.Resource {
 				Computed: true,
 				// TODO Remove this in the next major version release
 				Deprecated:   "Use the `address_prefixes` property instead.",
-				ExactlyOneOf: []string{"address_prefix", "address_prefixes"},
+				ExactlyOneOf: []string{"address_prefix", "address_prefixes", "address_prefix_length"},
 			},
 
 			"address_prefixes": {
@@ -73,7 +74,15 @@ func resourceSubnet() *pluginsdk.Resource {
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
-				ExactlyOneOf: []string{"address_prefix", "address_prefixes"},
+				ExactlyOneOf: []string{"address_prefix", "address_prefixes", "address_prefix_length"},
+			},
+
+			// the allocated range is exposed via `address_prefixes`, so that it remains stable once allocated
+			"address_prefix_length": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(8, 29),
+				ExactlyOneOf: []string{"address_prefix", "address_prefixes", "address_prefix_length"},
 			},
 
 			"service_endpoints": {
@@ -129,6 +138,7 @@ func resourceSubnet() *pluginsdk.Resource {
 											"Microsoft.Logic/integrationServiceEnvironments",
 											"Microsoft.MachineLearningServices/workspaces",
 											"Microsoft.Netapp/volumes",
+											"Microsoft.Network/dnsResolvers",
 											"Microsoft.Network/managedResolvers",
 											"Microsoft.PowerPlatform/vnetaccesslinks",
 											"Microsoft.ServiceFabricMesh/networks",
@@ -177,7 +187,39 @@ func resourceSubnet() *pluginsdk.Resource {
 				Default:  false,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			subnetAddressPrefixLengthCustomizeDiff,
+			subnetAddressPrefixOverlapCustomizeDiff,
+		),
+	}
+}
+
+// changing `address_prefix_length` requires a new range to be allocated - unless the Subnet is switching to
+// `address_prefix_length` and the existing range already has the requested prefix length
+func subnetAddressPrefixLengthCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("address_prefix_length") {
+		return nil
+	}
+
+	old, new := d.GetChange("address_prefix_length")
+	if new.(int) == 0 {
+		// switching to `address_prefixes` is handled as an in-place update
+		return nil
+	}
+
+	if old.(int) == 0 {
+		addressPrefixes := d.Get("address_prefixes").([]interface{})
+		if len(addressPrefixes) == 1 {
+			if _, ipNet, err := net.ParseCIDR(addressPrefixes[0].(string)); err == nil {
+				if ones, _ := ipNet.Mask.Size(); ones == new.(int) {
+					return nil
+				}
+			}
+		}
 	}
+
+	return d.ForceNew("address_prefix_length")
 }
 
 // TODO: refactor the create/flatten functions
@@ -217,6 +259,21 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		addressPrefix := value.(string)
 		properties.AddressPrefix = &addressPrefix
 	}
+	if prefixLength := d.Get("address_prefix_length").(int); prefixLength > 0 {
+		vnet, err := vnetClient.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, "")
+		if err != nil {
+			return fmt.Errorf("retrieving Virtual Network for %s: %+v", id, err)
+		}
+
+		addressSpaces, existingPrefixes := subnetAddressPrefixesFromVirtualNetwork(vnet)
+		addressPrefix, err := allocateSubnetAddressPrefix(addressSpaces, existingPrefixes, prefixLength)
+		if err != nil {
+			return fmt.Errorf("allocating an address range for %s: %+v", id, err)
+		}
+
+		log.Printf("[DEBUG] Allocated the address range %q for %s", *addressPrefix, id)
+		properties.AddressPrefix = addressPrefix
+	}
 	if properties.AddressPrefixes != nil && len(*properties.AddressPrefixes) == 1 {
 		properties.AddressPrefix = &(*properties.AddressPrefixes)[0]
 		properties.AddressPrefixes = nil
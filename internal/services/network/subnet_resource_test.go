@@ -301,6 +301,23 @@ func TestAccSubnet_updateAddressPrefix(t *testing.T) {
 	})
 }
 
+func TestAccSubnet_addressPrefixLength(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subnet", "test")
+	r := SubnetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.addressPrefixLength(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("address_prefixes.#").HasValue("1"),
+				check.That("azurerm_subnet.test2").Key("address_prefixes.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("address_prefix_length"),
+	})
+}
+
 func (t SubnetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.SubnetID(state.ID)
 	if err != nil {
@@ -664,6 +681,28 @@ resource "azurerm_subnet" "test" {
 `, r.template(data))
 }
 
+func (r SubnetResource) addressPrefixLength(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet" "test" {
+  name                  = "internal"
+  resource_group_name   = azurerm_resource_group.test.name
+  virtual_network_name  = azurerm_virtual_network.test.name
+  address_prefix_length = 24
+}
+
+resource "azurerm_subnet" "test2" {
+  name                  = "internal2"
+  resource_group_name   = azurerm_resource_group.test.name
+  virtual_network_name  = azurerm_virtual_network.test.name
+  address_prefix_length = 26
+
+  depends_on = [azurerm_subnet.test]
+}
+`, r.template(data))
+}
+
 func (SubnetResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
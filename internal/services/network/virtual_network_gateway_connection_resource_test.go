@@ -181,6 +181,21 @@ func TestAccVirtualNetworkGatewayConnection_useLocalAzureIpAddressEnabled(t *tes
 	})
 }
 
+func TestAccVirtualNetworkGatewayConnection_natRules(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_gateway_connection", "test")
+	r := VirtualNetworkGatewayConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.natRules(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t VirtualNetworkGatewayConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	gatewayName := state.Attributes["name"]
 	resourceGroup := state.Attributes["resource_group_name"]
@@ -879,3 +894,60 @@ resource "azurerm_virtual_network_gateway_connection" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
+
+func (VirtualNetworkGatewayConnectionResource) natRules(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_virtual_network_gateway_nat_rule" "egress" {
+  name                       = "acctest-vnetgwnatrule-egress-%[2]d"
+  virtual_network_gateway_id = azurerm_virtual_network_gateway.test.id
+  mode                       = "EgressSnat"
+
+  external_mapping {
+    address_space = "10.2.0.0/26"
+  }
+
+  internal_mapping {
+    address_space = "10.4.0.0/26"
+  }
+}
+
+resource "azurerm_virtual_network_gateway_nat_rule" "ingress" {
+  name                       = "acctest-vnetgwnatrule-ingress-%[2]d"
+  virtual_network_gateway_id = azurerm_virtual_network_gateway.test.id
+  mode                       = "IngressSnat"
+
+  external_mapping {
+    address_space = "10.3.0.0/26"
+  }
+
+  internal_mapping {
+    address_space = "10.1.1.0/26"
+  }
+}
+
+resource "azurerm_local_network_gateway" "test" {
+  name                = "acctest-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  gateway_address = "168.62.225.23"
+  address_space   = ["10.1.1.0/24"]
+}
+
+resource "azurerm_virtual_network_gateway_connection" "test" {
+  name                = "acctest-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  type                       = "IPsec"
+  virtual_network_gateway_id = azurerm_virtual_network_gateway.test.id
+  local_network_gateway_id   = azurerm_local_network_gateway.test.id
+  egress_nat_rule_ids        = [azurerm_virtual_network_gateway_nat_rule.egress.id]
+  ingress_nat_rule_ids       = [azurerm_virtual_network_gateway_nat_rule.ingress.id]
+
+  shared_key = "4-v3ry-53cr37-1p53c-5h4r3d-k3y"
+}
+`, VirtualNetworkGatewayNatRuleResource{}.template(data), data.RandomInteger)
+}
@@ -0,0 +1,279 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceVirtualNetworkGatewayNatRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVirtualNetworkGatewayNatRuleCreateUpdate,
+		Read:   resourceVirtualNetworkGatewayNatRuleRead,
+		Update: resourceVirtualNetworkGatewayNatRuleCreateUpdate,
+		Delete: resourceVirtualNetworkGatewayNatRuleDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.VirtualNetworkGatewayNatRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"virtual_network_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualNetworkGatewayID,
+			},
+
+			"external_mapping": vpnNatRuleMappingSchema(),
+
+			"internal_mapping": vpnNatRuleMappingSchema(),
+
+			"ip_configuration_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.VirtualNetworkGatewayIpConfigurationID,
+			},
+
+			"mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(network.VpnNatRuleModeEgressSnat),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.VpnNatRuleModeEgressSnat),
+					string(network.VpnNatRuleModeIngressSnat),
+				}, false),
+			},
+
+			"type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(network.VpnNatRuleTypeStatic),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.VpnNatRuleTypeStatic),
+					string(network.VpnNatRuleTypeDynamic),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceVirtualNetworkGatewayNatRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetGatewayNatRuleClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.VirtualNetworkGatewayID(d.Get("virtual_network_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewVirtualNetworkGatewayNatRuleID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkGatewayName, id.NatRuleName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_virtual_network_gateway_nat_rule", id.ID())
+		}
+	}
+
+	locks.ByName(gatewayId.Name, virtualNetworkGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.Name, virtualNetworkGatewayResourceName)
+
+	props := network.VirtualNetworkGatewayNatRule{
+		Name: utils.String(id.NatRuleName),
+		VirtualNetworkGatewayNatRuleProperties: &network.VirtualNetworkGatewayNatRuleProperties{
+			ExternalMappings: expandVpnNatRuleMappings(d.Get("external_mapping").([]interface{})),
+			InternalMappings: expandVpnNatRuleMappings(d.Get("internal_mapping").([]interface{})),
+			Mode:             network.VpnNatRuleMode(d.Get("mode").(string)),
+			Type:             network.VpnNatRuleType(d.Get("type").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("ip_configuration_id"); ok {
+		props.VirtualNetworkGatewayNatRuleProperties.IPConfigurationID = utils.String(v.(string))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualNetworkGatewayName, id.NatRuleName, props)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualNetworkGatewayNatRuleRead(d, meta)
+}
+
+func resourceVirtualNetworkGatewayNatRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetGatewayNatRuleClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualNetworkGatewayNatRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkGatewayName, id.NatRuleName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.NatRuleName)
+	d.Set("virtual_network_gateway_id", parse.NewVirtualNetworkGatewayID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkGatewayName).ID())
+
+	if props := resp.VirtualNetworkGatewayNatRuleProperties; props != nil {
+		d.Set("ip_configuration_id", props.IPConfigurationID)
+		d.Set("mode", string(props.Mode))
+		d.Set("type", string(props.Type))
+
+		if err := d.Set("external_mapping", flattenVpnNatRuleMappings(props.ExternalMappings)); err != nil {
+			return fmt.Errorf("setting `external_mapping`: %+v", err)
+		}
+
+		if err := d.Set("internal_mapping", flattenVpnNatRuleMappings(props.InternalMappings)); err != nil {
+			return fmt.Errorf("setting `internal_mapping`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceVirtualNetworkGatewayNatRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetGatewayNatRuleClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualNetworkGatewayNatRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.VirtualNetworkGatewayName, virtualNetworkGatewayResourceName)
+	defer locks.UnlockByName(id.VirtualNetworkGatewayName, virtualNetworkGatewayResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualNetworkGatewayName, id.NatRuleName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func vpnNatRuleMappingSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"address_space": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsCIDR,
+				},
+
+				"port_range": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: azValidate.PortOrPortRangeWithin(1, 65535),
+				},
+			},
+		},
+	}
+}
+
+func expandVpnNatRuleMappings(input []interface{}) *[]network.VpnNatRuleMapping {
+	results := make([]network.VpnNatRuleMapping, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		mapping := network.VpnNatRuleMapping{
+			AddressSpace: utils.String(v["address_space"].(string)),
+		}
+
+		if portRange := v["port_range"].(string); portRange != "" {
+			mapping.PortRange = utils.String(portRange)
+		}
+
+		results = append(results, mapping)
+	}
+
+	return &results
+}
+
+func flattenVpnNatRuleMappings(input *[]network.VpnNatRuleMapping) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		addressSpace := ""
+		if item.AddressSpace != nil {
+			addressSpace = *item.AddressSpace
+		}
+
+		portRange := ""
+		if item.PortRange != nil {
+			portRange = *item.PortRange
+		}
+
+		results = append(results, map[string]interface{}{
+			"address_space": addressSpace,
+			"port_range":    portRange,
+		})
+	}
+
+	return results
+}
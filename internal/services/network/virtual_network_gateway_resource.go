@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+var virtualNetworkGatewayResourceName = "azurerm_virtual_network_gateway"
+
 func resourceVirtualNetworkGateway() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceVirtualNetworkGatewayCreateUpdate,
@@ -91,6 +94,12 @@ func resourceVirtualNetworkGateway() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"bgp_route_translation_for_nat_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"sku": {
 				Type:             pluginsdk.TypeString,
 				Required:         true,
@@ -415,6 +424,9 @@ func resourceVirtualNetworkGatewayCreateUpdate(d *pluginsdk.ResourceData, meta i
 		}
 	}
 
+	locks.ByName(id.Name, virtualNetworkGatewayResourceName)
+	defer locks.UnlockByName(id.Name, virtualNetworkGatewayResourceName)
+
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
@@ -423,6 +435,18 @@ func resourceVirtualNetworkGatewayCreateUpdate(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
+	if !d.IsNewResource() {
+		// the NAT Rules are managed by the `azurerm_virtual_network_gateway_nat_rule` resource, so the existing NAT Rules
+		// need to be included to avoid removing them
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		if existing.VirtualNetworkGatewayPropertiesFormat != nil {
+			properties.NatRules = existing.VirtualNetworkGatewayPropertiesFormat.NatRules
+		}
+	}
+
 	gateway := network.VirtualNetworkGateway{
 		Name:                                  &id.Name,
 		Location:                              &location,
@@ -474,6 +498,7 @@ func resourceVirtualNetworkGatewayRead(d *pluginsdk.ResourceData, meta interface
 		d.Set("enable_bgp", gw.EnableBgp)
 		d.Set("private_ip_address_enabled", gw.EnablePrivateIPAddress)
 		d.Set("active_active", gw.ActiveActive)
+		d.Set("bgp_route_translation_for_nat_enabled", gw.EnableBgpRouteTranslationForNat)
 		d.Set("generation", string(gw.VpnGatewayGeneration))
 
 		if string(gw.VpnType) != "" {
@@ -544,15 +569,16 @@ func getVirtualNetworkGatewayProperties(id parse.VirtualNetworkGatewayId, d *plu
 	customRoute := d.Get("custom_route").([]interface{})
 
 	props := &network.VirtualNetworkGatewayPropertiesFormat{
-		GatewayType:            gatewayType,
-		VpnType:                vpnType,
-		EnableBgp:              &enableBgp,
-		EnablePrivateIPAddress: &enablePrivateIpAddress,
-		ActiveActive:           &activeActive,
-		VpnGatewayGeneration:   generation,
-		Sku:                    expandVirtualNetworkGatewaySku(d),
-		IPConfigurations:       expandVirtualNetworkGatewayIPConfigurations(d),
-		CustomRoutes:           expandVirtualNetworkGatewayAddressSpace(customRoute),
+		GatewayType:                     gatewayType,
+		VpnType:                         vpnType,
+		EnableBgp:                       &enableBgp,
+		EnablePrivateIPAddress:          &enablePrivateIpAddress,
+		ActiveActive:                    &activeActive,
+		EnableBgpRouteTranslationForNat: utils.Bool(d.Get("bgp_route_translation_for_nat_enabled").(bool)),
+		VpnGatewayGeneration:            generation,
+		Sku:                             expandVirtualNetworkGatewaySku(d),
+		IPConfigurations:                expandVirtualNetworkGatewayIPConfigurations(d),
+		CustomRoutes:                    expandVirtualNetworkGatewayAddressSpace(customRoute),
 	}
 
 	if gatewayDefaultSiteID := d.Get("default_local_network_gateway_id").(string); gatewayDefaultSiteID != "" {
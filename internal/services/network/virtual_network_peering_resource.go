@@ -84,6 +84,8 @@ func resourceVirtualNetworkPeering() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(virtualNetworkPeeringAddressSpaceOverlapCustomizeDiff),
 	}
 }
 
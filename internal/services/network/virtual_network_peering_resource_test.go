@@ -3,6 +3,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -96,6 +97,21 @@ func TestAccVirtualNetworkPeering_update(t *testing.T) {
 	})
 }
 
+func TestAccVirtualNetworkPeering_overlappingAddressSpace(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_peering", "test1")
+	r := VirtualNetworkPeeringResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.overlappingVirtualNetworks(data),
+		},
+		{
+			Config:      r.overlappingAddressSpace(data),
+			ExpectError: regexp.MustCompile("overlaps the address space"),
+		},
+	})
+}
+
 func (t VirtualNetworkPeeringResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.VirtualNetworkPeeringID(state.ID)
 	if err != nil {
@@ -170,6 +186,46 @@ resource "azurerm_virtual_network_peering" "test2" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
 
+func (VirtualNetworkPeeringResource) overlappingVirtualNetworks(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test1" {
+  name                = "acctestvirtnet-1-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.0.1.0/24"]
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_virtual_network" "test2" {
+  name                = "acctestvirtnet-2-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.0.1.128/25"]
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
+func (r VirtualNetworkPeeringResource) overlappingAddressSpace(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network_peering" "test1" {
+  name                      = "acctestpeer-1-%d"
+  resource_group_name       = azurerm_resource_group.test.name
+  virtual_network_name      = azurerm_virtual_network.test1.name
+  remote_virtual_network_id = azurerm_virtual_network.test2.id
+}
+`, r.overlappingVirtualNetworks(data), data.RandomInteger)
+}
+
 func (r VirtualNetworkPeeringResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
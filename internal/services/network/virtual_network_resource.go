@@ -154,6 +154,8 @@ func resourceVirtualNetwork() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(virtualNetworkAddressSpaceOverlapCustomizeDiff),
 	}
 }
 
@@ -311,6 +311,24 @@ func resourceVPNGatewayConnection() *pluginsdk.Resource {
 							Optional: true,
 							Default:  false,
 						},
+
+						"egress_nat_rule_ids": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validate.VpnGatewayNatRuleID,
+							},
+						},
+
+						"ingress_nat_rule_ids": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validate.VpnGatewayNatRuleID,
+							},
+						},
 					},
 				},
 			},
@@ -522,6 +540,8 @@ func expandVpnGatewayConnectionVpnSiteLinkConnections(input []interface{}) *[]ne
 				EnableRateLimiting:             utils.Bool(e["ratelimit_enabled"].(bool)),
 				UseLocalAzureIPAddress:         utils.Bool(e["local_azure_ip_address_enabled"].(bool)),
 				UsePolicyBasedTrafficSelectors: utils.Bool(e["policy_based_traffic_selector_enabled"].(bool)),
+				EgressNatRules:                 expandNetworkSubResourceID(e["egress_nat_rule_ids"].(*pluginsdk.Set).List()),
+				IngressNatRules:                expandNetworkSubResourceID(e["ingress_nat_rule_ids"].(*pluginsdk.Set).List()),
 			},
 		}
 
@@ -601,6 +621,8 @@ func flattenVpnGatewayConnectionVpnSiteLinkConnections(input *[]network.VpnSiteL
 			"ratelimit_enabled":                     rateLimitEnabled,
 			"local_azure_ip_address_enabled":        useLocalAzureIpAddress,
 			"policy_based_traffic_selector_enabled": usePolicyBased,
+			"egress_nat_rule_ids":                   flattenNetworkSubResourceID(e.EgressNatRules),
+			"ingress_nat_rule_ids":                  flattenNetworkSubResourceID(e.IngressNatRules),
 		}
 
 		output = append(output, v)
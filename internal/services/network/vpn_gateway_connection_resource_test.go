@@ -185,6 +185,28 @@ func TestAccVpnGatewayConnection_updateTrafficSelectorPolicy(t *testing.T) {
 	})
 }
 
+func TestAccVpnGatewayConnection_natRules(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vpn_gateway_connection", "test")
+	r := VPNGatewayConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.natRules(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t VPNGatewayConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.VpnConnectionID(state.ID)
 	if err != nil {
@@ -411,6 +433,56 @@ resource "azurerm_vpn_gateway_connection" "test" {
 `, r.template(data), data.RandomInteger, localAddressRange, remoteAddressRange)
 }
 
+func (r VPNGatewayConnectionResource) natRules(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway_nat_rule" "egress" {
+  name           = "acctest-vpngwnatrule-egress-%[2]d"
+  vpn_gateway_id = azurerm_vpn_gateway.test.id
+  mode           = "EgressSnat"
+
+  external_mapping {
+    address_space = "192.168.21.0/26"
+  }
+
+  internal_mapping {
+    address_space = "10.4.0.0/26"
+  }
+}
+
+resource "azurerm_vpn_gateway_nat_rule" "ingress" {
+  name           = "acctest-vpngwnatrule-ingress-%[2]d"
+  vpn_gateway_id = azurerm_vpn_gateway.test.id
+  mode           = "IngressSnat"
+
+  external_mapping {
+    address_space = "192.168.22.0/26"
+  }
+
+  internal_mapping {
+    address_space = "10.5.0.0/26"
+  }
+}
+
+resource "azurerm_vpn_gateway_connection" "test" {
+  name               = "acctest-VpnGwConn-%[2]d"
+  vpn_gateway_id     = azurerm_vpn_gateway.test.id
+  remote_vpn_site_id = azurerm_vpn_site.test.id
+  vpn_link {
+    name                 = "link1"
+    vpn_site_link_id     = azurerm_vpn_site.test.link[0].id
+    egress_nat_rule_ids  = [azurerm_vpn_gateway_nat_rule.egress.id]
+    ingress_nat_rule_ids = [azurerm_vpn_gateway_nat_rule.ingress.id]
+  }
+  vpn_link {
+    name             = "link2"
+    vpn_site_link_id = azurerm_vpn_site.test.link[1].id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (VPNGatewayConnectionResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,212 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceVPNGatewayNatRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVPNGatewayNatRuleCreateUpdate,
+		Read:   resourceVPNGatewayNatRuleRead,
+		Update: resourceVPNGatewayNatRuleCreateUpdate,
+		Delete: resourceVPNGatewayNatRuleDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.VpnGatewayNatRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"vpn_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VpnGatewayID,
+			},
+
+			"external_mapping": vpnNatRuleMappingSchema(),
+
+			"internal_mapping": vpnNatRuleMappingSchema(),
+
+			"ip_configuration_id": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Instance0",
+					"Instance1",
+				}, false),
+			},
+
+			"mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(network.VpnNatRuleModeEgressSnat),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.VpnNatRuleModeEgressSnat),
+					string(network.VpnNatRuleModeIngressSnat),
+				}, false),
+			},
+
+			"type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(network.VpnNatRuleTypeStatic),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.VpnNatRuleTypeStatic),
+					string(network.VpnNatRuleTypeDynamic),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceVPNGatewayNatRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VpnGatewayNatRuleClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.VpnGatewayID(d.Get("vpn_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewVpnGatewayNatRuleID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.VpnGatewayName, id.NatRuleName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_vpn_gateway_nat_rule", id.ID())
+		}
+	}
+
+	locks.ByName(gatewayId.Name, VPNGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.Name, VPNGatewayResourceName)
+
+	props := network.VpnGatewayNatRule{
+		Name: utils.String(id.NatRuleName),
+		VpnGatewayNatRuleProperties: &network.VpnGatewayNatRuleProperties{
+			ExternalMappings: expandVpnNatRuleMappings(d.Get("external_mapping").([]interface{})),
+			InternalMappings: expandVpnNatRuleMappings(d.Get("internal_mapping").([]interface{})),
+			Mode:             network.VpnNatRuleMode(d.Get("mode").(string)),
+			Type:             network.VpnNatRuleType(d.Get("type").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("ip_configuration_id"); ok {
+		props.VpnGatewayNatRuleProperties.IPConfigurationID = utils.String(v.(string))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VpnGatewayName, id.NatRuleName, props)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVPNGatewayNatRuleRead(d, meta)
+}
+
+func resourceVPNGatewayNatRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VpnGatewayNatRuleClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VpnGatewayNatRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VpnGatewayName, id.NatRuleName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.NatRuleName)
+	d.Set("vpn_gateway_id", parse.NewVpnGatewayID(id.SubscriptionId, id.ResourceGroup, id.VpnGatewayName).ID())
+
+	if props := resp.VpnGatewayNatRuleProperties; props != nil {
+		d.Set("ip_configuration_id", props.IPConfigurationID)
+		d.Set("mode", string(props.Mode))
+		d.Set("type", string(props.Type))
+
+		if err := d.Set("external_mapping", flattenVpnNatRuleMappings(props.ExternalMappings)); err != nil {
+			return fmt.Errorf("setting `external_mapping`: %+v", err)
+		}
+
+		if err := d.Set("internal_mapping", flattenVpnNatRuleMappings(props.InternalMappings)); err != nil {
+			return fmt.Errorf("setting `internal_mapping`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceVPNGatewayNatRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VpnGatewayNatRuleClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VpnGatewayNatRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.VpnGatewayName, VPNGatewayResourceName)
+	defer locks.UnlockByName(id.VpnGatewayName, VPNGatewayResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.VpnGatewayName, id.NatRuleName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VPNGatewayNatRuleResource struct{}
+
+func TestAccVPNGatewayNatRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vpn_gateway_nat_rule", "test")
+	r := VPNGatewayNatRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVPNGatewayNatRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vpn_gateway_nat_rule", "test")
+	r := VPNGatewayNatRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVPNGatewayNatRule_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vpn_gateway_nat_rule", "test")
+	r := VPNGatewayNatRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVPNGatewayNatRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vpn_gateway_nat_rule", "test")
+	r := VPNGatewayNatRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t VPNGatewayNatRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.VpnGatewayNatRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.VpnGatewayNatRuleClient.Get(ctx, id.ResourceGroup, id.VpnGatewayName, id.NatRuleName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.VpnGatewayNatRuleProperties != nil), nil
+}
+
+func (r VPNGatewayNatRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway_nat_rule" "test" {
+  name           = "acctest-vpngwnatrule-%d"
+  vpn_gateway_id = azurerm_vpn_gateway.test.id
+
+  external_mapping {
+    address_space = "10.2.0.0/26"
+  }
+
+  internal_mapping {
+    address_space = "10.4.0.0/26"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VPNGatewayNatRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway_nat_rule" "import" {
+  name           = azurerm_vpn_gateway_nat_rule.test.name
+  vpn_gateway_id = azurerm_vpn_gateway_nat_rule.test.vpn_gateway_id
+
+  external_mapping {
+    address_space = "10.2.0.0/26"
+  }
+
+  internal_mapping {
+    address_space = "10.4.0.0/26"
+  }
+}
+`, r.basic(data))
+}
+
+func (r VPNGatewayNatRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway_nat_rule" "test" {
+  name                = "acctest-vpngwnatrule-%d"
+  vpn_gateway_id      = azurerm_vpn_gateway.test.id
+  ip_configuration_id = "Instance0"
+  mode                = "IngressSnat"
+  type                = "Static"
+
+  external_mapping {
+    address_space = "10.2.0.0/26"
+  }
+
+  external_mapping {
+    address_space = "10.3.0.0/26"
+    port_range    = "10000-11000"
+  }
+
+  internal_mapping {
+    address_space = "10.4.0.0/26"
+  }
+
+  internal_mapping {
+    address_space = "10.5.0.0/26"
+    port_range    = "10000-11000"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (VPNGatewayNatRuleResource) template(data acceptance.TestData) string {
+	return VPNGatewayResource{}.basic(data)
+}
@@ -3,12 +3,25 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2020-04-01-preview/dnsforwardingrulesets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2020-04-01-preview/dnsresolvers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2020-04-01-preview/forwardingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2020-04-01-preview/inboundendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2020-04-01-preview/outboundendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2020-04-01-preview/virtualnetworklinks"
 )
 
 type Client struct {
 	RecordSetsClient          *privatedns.RecordSetsClient
 	PrivateZonesClient        *privatedns.PrivateZonesClient
 	VirtualNetworkLinksClient *privatedns.VirtualNetworkLinksClient
+
+	DnsForwardingRulesetsClient       *dnsforwardingrulesets.DnsForwardingRulesetsClient
+	DnsResolversClient                *dnsresolvers.DnsResolversClient
+	ForwardingRulesClient             *forwardingrules.ForwardingRulesClient
+	InboundEndpointsClient            *inboundendpoints.InboundEndpointsClient
+	OutboundEndpointsClient           *outboundendpoints.OutboundEndpointsClient
+	ResolverVirtualNetworkLinksClient *virtualnetworklinks.VirtualNetworkLinksClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -21,9 +34,34 @@ func NewClient(o *common.ClientOptions) *Client {
 	virtualNetworkLinksClient := privatedns.NewVirtualNetworkLinksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&virtualNetworkLinksClient.Client, o.ResourceManagerAuthorizer)
 
+	dnsForwardingRulesetsClient := dnsforwardingrulesets.NewDnsForwardingRulesetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&dnsForwardingRulesetsClient.Client, o.ResourceManagerAuthorizer)
+
+	dnsResolversClient := dnsresolvers.NewDnsResolversClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&dnsResolversClient.Client, o.ResourceManagerAuthorizer)
+
+	forwardingRulesClient := forwardingrules.NewForwardingRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&forwardingRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	inboundEndpointsClient := inboundendpoints.NewInboundEndpointsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&inboundEndpointsClient.Client, o.ResourceManagerAuthorizer)
+
+	outboundEndpointsClient := outboundendpoints.NewOutboundEndpointsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&outboundEndpointsClient.Client, o.ResourceManagerAuthorizer)
+
+	resolverVirtualNetworkLinksClient := virtualnetworklinks.NewVirtualNetworkLinksClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&resolverVirtualNetworkLinksClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		RecordSetsClient:          &recordSetsClient,
 		PrivateZonesClient:        &privateZonesClient,
 		VirtualNetworkLinksClient: &virtualNetworkLinksClient,
+
+		DnsForwardingRulesetsClient:       &dnsForwardingRulesetsClient,
+		DnsResolversClient:                &dnsResolversClient,
+		ForwardingRulesClient:             &forwardingRulesClient,
+		InboundEndpointsClient:            &inboundEndpointsClient,
+		OutboundEndpointsClient:           &outboundEndpointsClient,
+		ResolverVirtualNetworkLinksClient: &resolverVirtualNetworkLinksClient,
 	}
 }
@@ -0,0 +1,236 @@
+package privatedns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2020-04-01-preview/dnsforwardingrulesets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2020-04-01-preview/outboundendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type PrivateDnsResolverDnsForwardingRulesetModel struct {
+	Name                                  string            `tfschema:"name"`
+	ResourceGroupName                     string            `tfschema:"resource_group_name"`
+	Location                              string            `tfschema:"location"`
+	PrivateDnsResolverOutboundEndpointIds []string          `tfschema:"private_dns_resolver_outbound_endpoint_ids"`
+	Tags                                  map[string]string `tfschema:"tags"`
+}
+
+type PrivateDnsResolverDnsForwardingRulesetResource struct{}
+
+var _ sdk.ResourceWithUpdate = PrivateDnsResolverDnsForwardingRulesetResource{}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_dns_forwarding_ruleset"
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) ModelObject() interface{} {
+	return &PrivateDnsResolverDnsForwardingRulesetModel{}
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return dnsforwardingrulesets.ValidateDnsForwardingRulesetID
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PrivateDnsResolverName,
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"private_dns_resolver_outbound_endpoint_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: outboundendpoints.ValidateOutboundEndpointID,
+			},
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model PrivateDnsResolverDnsForwardingRulesetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.PrivateDns.DnsForwardingRulesetsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := dnsforwardingrulesets.NewDnsForwardingRulesetID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := dnsforwardingrulesets.DnsForwardingRuleset{
+				Location: location.Normalize(model.Location),
+				Properties: dnsforwardingrulesets.DnsForwardingRulesetProperties{
+					DnsResolverOutboundEndpoints: expandDnsForwardingRulesetOutboundEndpoints(model.PrivateDnsResolverOutboundEndpointIds),
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.DnsForwardingRulesetsClient
+
+			id, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDnsResolverDnsForwardingRulesetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			properties := resp.Model
+			if properties == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			if metadata.ResourceData.HasChange("private_dns_resolver_outbound_endpoint_ids") {
+				properties.Properties.DnsResolverOutboundEndpoints = expandDnsForwardingRulesetOutboundEndpoints(model.PrivateDnsResolverOutboundEndpointIds)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				properties.Tags = &model.Tags
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.DnsForwardingRulesetsClient
+
+			id, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateDnsResolverDnsForwardingRulesetModel{
+				Name:              id.DnsForwardingRulesetName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.PrivateDnsResolverOutboundEndpointIds = flattenDnsForwardingRulesetOutboundEndpoints(model.Properties.DnsResolverOutboundEndpoints)
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.DnsForwardingRulesetsClient
+
+			id, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDnsForwardingRulesetOutboundEndpoints(input []string) *[]dnsforwardingrulesets.SubResource {
+	output := make([]dnsforwardingrulesets.SubResource, 0)
+	for _, v := range input {
+		output = append(output, dnsforwardingrulesets.SubResource{
+			Id: v,
+		})
+	}
+
+	return &output
+}
+
+func flattenDnsForwardingRulesetOutboundEndpoints(input *[]dnsforwardingrulesets.SubResource) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, v.Id)
+	}
+
+	return output
+}
@@ -0,0 +1,163 @@
+package privatedns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2020-04-01-preview/dnsforwardingrulesets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PrivateDnsResolverDnsForwardingRulesetResource struct{}
+
+func TestAccPrivateDnsResolverDnsForwardingRuleset_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_dns_forwarding_ruleset", "test")
+	r := PrivateDnsResolverDnsForwardingRulesetResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPrivateDnsResolverDnsForwardingRuleset_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_dns_forwarding_ruleset", "test")
+	r := PrivateDnsResolverDnsForwardingRulesetResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPrivateDnsResolverDnsForwardingRuleset_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_dns_forwarding_ruleset", "test")
+	r := PrivateDnsResolverDnsForwardingRulesetResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("private_dns_resolver_outbound_endpoint_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("private_dns_resolver_outbound_endpoint_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.PrivateDns.DnsForwardingRulesetsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_subnet" "second" {
+  name                 = "outbounddns2"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.0.80/28"]
+
+  delegation {
+    name = "Microsoft.Network.dnsResolvers"
+    service_delegation {
+      actions = ["Microsoft.Network/virtualNetworks/subnets/join/action"]
+      name    = "Microsoft.Network/dnsResolvers"
+    }
+  }
+}
+
+resource "azurerm_private_dns_resolver_outbound_endpoint" "second" {
+  name                    = "acctest-droe2-%[2]d"
+  private_dns_resolver_id = azurerm_private_dns_resolver.test.id
+  location                = azurerm_private_dns_resolver.test.location
+  subnet_id               = azurerm_subnet.second.id
+}
+`, PrivateDnsResolverOutboundEndpointResource{}.basic(data), data.RandomInteger)
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_dns_forwarding_ruleset" "test" {
+  name                                       = "acctest-drdfr-%d"
+  resource_group_name                        = azurerm_resource_group.test.name
+  location                                   = azurerm_resource_group.test.location
+  private_dns_resolver_outbound_endpoint_ids = [azurerm_private_dns_resolver_outbound_endpoint.test.id]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_dns_forwarding_ruleset" "import" {
+  name                                       = azurerm_private_dns_resolver_dns_forwarding_ruleset.test.name
+  resource_group_name                        = azurerm_private_dns_resolver_dns_forwarding_ruleset.test.resource_group_name
+  location                                   = azurerm_private_dns_resolver_dns_forwarding_ruleset.test.location
+  private_dns_resolver_outbound_endpoint_ids = azurerm_private_dns_resolver_dns_forwarding_ruleset.test.private_dns_resolver_outbound_endpoint_ids
+}
+`, r.basic(data))
+}
+
+func (r PrivateDnsResolverDnsForwardingRulesetResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_resolver_dns_forwarding_ruleset" "test" {
+  name                = "acctest-drdfr-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  private_dns_resolver_outbound_endpoint_ids = [
+    azurerm_private_dns_resolver_outbound_endpoint.test.id,
+    azurerm_private_dns_resolver_outbound_endpoint.second.id,
+  ]
+
+  tags = {
+    key = "value"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,307 @@
+package privatedns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2020-04-01-preview/dnsforwardingrulesets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2020-04-01-preview/forwardingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PrivateDnsResolverForwardingRuleModel struct {
+	Name                   string                                   `tfschema:"name"`
+	DnsForwardingRulesetId string                                   `tfschema:"dns_forwarding_ruleset_id"`
+	DomainName             string                                   `tfschema:"domain_name"`
+	TargetDnsServers       []PrivateDnsResolverTargetDnsServerModel `tfschema:"target_dns_servers"`
+	Enabled                bool                                     `tfschema:"enabled"`
+	Metadata               map[string]string                        `tfschema:"metadata"`
+}
+
+type PrivateDnsResolverTargetDnsServerModel struct {
+	IpAddress string `tfschema:"ip_address"`
+	Port      int    `tfschema:"port"`
+}
+
+type PrivateDnsResolverForwardingRuleResource struct{}
+
+var _ sdk.ResourceWithUpdate = PrivateDnsResolverForwardingRuleResource{}
+
+func (r PrivateDnsResolverForwardingRuleResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_forwarding_rule"
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) ModelObject() interface{} {
+	return &PrivateDnsResolverForwardingRuleModel{}
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return forwardingrules.ValidateForwardingRuleID
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PrivateDnsResolverName,
+		},
+
+		"dns_forwarding_ruleset_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: dnsforwardingrulesets.ValidateDnsForwardingRulesetID,
+		},
+
+		"domain_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.PrivateDnsResolverForwardingRuleDomainName,
+		},
+
+		"target_dns_servers": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ip_address": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsIPv4Address,
+					},
+
+					"port": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      53,
+						ValidateFunc: validation.IsPortNumber,
+					},
+				},
+			},
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"metadata": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model PrivateDnsResolverForwardingRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.PrivateDns.ForwardingRulesClient
+
+			rulesetId, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(model.DnsForwardingRulesetId)
+			if err != nil {
+				return err
+			}
+
+			id := forwardingrules.NewForwardingRuleID(rulesetId.SubscriptionId, rulesetId.ResourceGroupName, rulesetId.DnsForwardingRulesetName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := forwardingrules.ForwardingRule{
+				Properties: forwardingrules.ForwardingRuleProperties{
+					DomainName:          model.DomainName,
+					ForwardingRuleState: expandForwardingRuleState(model.Enabled),
+					Metadata:            &model.Metadata,
+					TargetDnsServers:    expandForwardingRuleTargetDnsServers(model.TargetDnsServers),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.ForwardingRulesClient
+
+			id, err := forwardingrules.ParseForwardingRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDnsResolverForwardingRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			properties := resp.Model
+			if properties == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			if metadata.ResourceData.HasChange("domain_name") {
+				properties.Properties.DomainName = model.DomainName
+			}
+
+			if metadata.ResourceData.HasChange("target_dns_servers") {
+				properties.Properties.TargetDnsServers = expandForwardingRuleTargetDnsServers(model.TargetDnsServers)
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				properties.Properties.ForwardingRuleState = expandForwardingRuleState(model.Enabled)
+			}
+
+			if metadata.ResourceData.HasChange("metadata") {
+				properties.Properties.Metadata = &model.Metadata
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, *properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.ForwardingRulesClient
+
+			id, err := forwardingrules.ParseForwardingRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateDnsResolverForwardingRuleModel{
+				Name:                   id.ForwardingRuleName,
+				DnsForwardingRulesetId: dnsforwardingrulesets.NewDnsForwardingRulesetID(id.SubscriptionId, id.ResourceGroupName, id.DnsForwardingRulesetName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				state.DomainName = props.DomainName
+				state.TargetDnsServers = flattenForwardingRuleTargetDnsServers(props.TargetDnsServers)
+
+				// the API defaults the state to Enabled when it's omitted
+				state.Enabled = props.ForwardingRuleState == nil || *props.ForwardingRuleState == forwardingrules.ForwardingRuleStateEnabled
+
+				if props.Metadata != nil {
+					state.Metadata = *props.Metadata
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDnsResolverForwardingRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.ForwardingRulesClient
+
+			id, err := forwardingrules.ParseForwardingRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.Delete(ctx, *id); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandForwardingRuleState(enabled bool) *forwardingrules.ForwardingRuleState {
+	state := forwardingrules.ForwardingRuleStateDisabled
+	if enabled {
+		state = forwardingrules.ForwardingRuleStateEnabled
+	}
+	return &state
+}
+
+func expandForwardingRuleTargetDnsServers(input []PrivateDnsResolverTargetDnsServerModel) []forwardingrules.TargetDnsServer {
+	output := make([]forwardingrules.TargetDnsServer, 0)
+	for _, item := range input {
+		output = append(output, forwardingrules.TargetDnsServer{
+			IpAddress: item.IpAddress,
+			Port:      utils.Int64(int64(item.Port)),
+		})
+	}
+
+	return output
+}
+
+func flattenForwardingRuleTargetDnsServers(input []forwardingrules.TargetDnsServer) []PrivateDnsResolverTargetDnsServerModel {
+	output := make([]PrivateDnsResolverTargetDnsServerModel, 0)
+	for _, item := range input {
+		// the API defaults the port to 53 when it's omitted
+		port := 53
+		if item.Port != nil {
+			port = int(*item.Port)
+		}
+
+		output = append(output, PrivateDnsResolverTargetDnsServerModel{
+			IpAddress: item.IpAddress,
+			Port:      port,
+		})
+	}
+
+	return output
+}
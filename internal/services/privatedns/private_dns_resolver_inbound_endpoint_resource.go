@@ -0,0 +1,317 @@
+package privatedns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2020-04-01-preview/dnsresolvers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2020-04-01-preview/inboundendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PrivateDnsResolverInboundEndpointModel struct {
+	Name                 string                                   `tfschema:"name"`
+	PrivateDnsResolverId string                                   `tfschema:"private_dns_resolver_id"`
+	Location             string                                   `tfschema:"location"`
+	IpConfigurations     []PrivateDnsResolverIpConfigurationModel `tfschema:"ip_configurations"`
+	Tags                 map[string]string                        `tfschema:"tags"`
+}
+
+type PrivateDnsResolverIpConfigurationModel struct {
+	SubnetId                  string `tfschema:"subnet_id"`
+	PrivateIpAllocationMethod string `tfschema:"private_ip_allocation_method"`
+	PrivateIpAddress          string `tfschema:"private_ip_address"`
+}
+
+type PrivateDnsResolverInboundEndpointResource struct{}
+
+var _ sdk.ResourceWithUpdate = PrivateDnsResolverInboundEndpointResource{}
+
+func (r PrivateDnsResolverInboundEndpointResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_inbound_endpoint"
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) ModelObject() interface{} {
+	return &PrivateDnsResolverInboundEndpointModel{}
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return inboundendpoints.ValidateInboundEndpointID
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PrivateDnsResolverName,
+		},
+
+		"private_dns_resolver_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: dnsresolvers.ValidateDnsResolverID,
+		},
+
+		"location": location.Schema(),
+
+		"ip_configurations": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"subnet_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: networkValidate.SubnetID,
+					},
+
+					"private_ip_allocation_method": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ForceNew: true,
+						Default:  string(inboundendpoints.IpAllocationMethodDynamic),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(inboundendpoints.IpAllocationMethodDynamic),
+							string(inboundendpoints.IpAllocationMethodStatic),
+						}, false),
+					},
+
+					"private_ip_address": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsIPv4Address,
+					},
+				},
+			},
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model PrivateDnsResolverInboundEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.PrivateDns.InboundEndpointsClient
+			resolversClient := metadata.Client.PrivateDns.DnsResolversClient
+			subnetsClient := metadata.Client.Network.SubnetsClient
+
+			resolverId, err := dnsresolvers.ParseDnsResolverID(model.PrivateDnsResolverId)
+			if err != nil {
+				return err
+			}
+
+			id := inboundendpoints.NewInboundEndpointID(resolverId.SubscriptionId, resolverId.ResourceGroupName, resolverId.DnsResolverName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			resolver, err := resolversClient.Get(ctx, *resolverId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *resolverId, err)
+			}
+			if resolver.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *resolverId)
+			}
+
+			for _, item := range model.IpConfigurations {
+				if item.PrivateIpAllocationMethod == string(inboundendpoints.IpAllocationMethodStatic) && item.PrivateIpAddress == "" {
+					return fmt.Errorf("`private_ip_address` must be specified when `private_ip_allocation_method` is set to `Static`")
+				}
+
+				if err := validatePrivateDnsResolverSubnet(ctx, subnetsClient, item.SubnetId, resolver.Model.Properties.VirtualNetwork.Id); err != nil {
+					return fmt.Errorf("validating the Subnet for %s: %+v", id, err)
+				}
+			}
+
+			properties := inboundendpoints.InboundEndpoint{
+				Location: location.Normalize(model.Location),
+				Properties: inboundendpoints.InboundEndpointProperties{
+					IpConfigurations: expandPrivateDnsResolverIpConfigurations(model.IpConfigurations),
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.InboundEndpointsClient
+
+			id, err := inboundendpoints.ParseInboundEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDnsResolverInboundEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			properties := resp.Model
+			if properties == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				properties.Tags = &model.Tags
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.InboundEndpointsClient
+
+			id, err := inboundendpoints.ParseInboundEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateDnsResolverInboundEndpointModel{
+				Name:                 id.InboundEndpointName,
+				PrivateDnsResolverId: dnsresolvers.NewDnsResolverID(id.SubscriptionId, id.ResourceGroupName, id.DnsResolverName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.IpConfigurations = flattenPrivateDnsResolverIpConfigurations(model.Properties.IpConfigurations)
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDnsResolverInboundEndpointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.InboundEndpointsClient
+
+			id, err := inboundendpoints.ParseInboundEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandPrivateDnsResolverIpConfigurations(input []PrivateDnsResolverIpConfigurationModel) *[]inboundendpoints.IpConfiguration {
+	output := make([]inboundendpoints.IpConfiguration, 0)
+	for _, item := range input {
+		allocationMethod := inboundendpoints.IpAllocationMethod(item.PrivateIpAllocationMethod)
+		ipConfiguration := inboundendpoints.IpConfiguration{
+			PrivateIpAllocationMethod: &allocationMethod,
+			Subnet: &inboundendpoints.SubResource{
+				Id: item.SubnetId,
+			},
+		}
+
+		if item.PrivateIpAddress != "" {
+			ipConfiguration.PrivateIpAddress = utils.String(item.PrivateIpAddress)
+		}
+
+		output = append(output, ipConfiguration)
+	}
+
+	return &output
+}
+
+func flattenPrivateDnsResolverIpConfigurations(input *[]inboundendpoints.IpConfiguration) []PrivateDnsResolverIpConfigurationModel {
+	output := make([]PrivateDnsResolverIpConfigurationModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		ipConfiguration := PrivateDnsResolverIpConfigurationModel{
+			PrivateIpAddress: utils.NormalizeNilableString(item.PrivateIpAddress),
+		}
+
+		if item.PrivateIpAllocationMethod != nil {
+			ipConfiguration.PrivateIpAllocationMethod = string(*item.PrivateIpAllocationMethod)
+		}
+
+		if item.Subnet != nil {
+			ipConfiguration.SubnetId = item.Subnet.Id
+		}
+
+		output = append(output, ipConfiguration)
+	}
+
+	return output
+}
@@ -0,0 +1,233 @@
+package privatedns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2020-04-01-preview/dnsresolvers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2020-04-01-preview/outboundendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type PrivateDnsResolverOutboundEndpointModel struct {
+	Name                 string            `tfschema:"name"`
+	PrivateDnsResolverId string            `tfschema:"private_dns_resolver_id"`
+	Location             string            `tfschema:"location"`
+	SubnetId             string            `tfschema:"subnet_id"`
+	Tags                 map[string]string `tfschema:"tags"`
+}
+
+type PrivateDnsResolverOutboundEndpointResource struct{}
+
+var _ sdk.ResourceWithUpdate = PrivateDnsResolverOutboundEndpointResource{}
+
+func (r PrivateDnsResolverOutboundEndpointResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_outbound_endpoint"
+}
+
+func (r PrivateDnsResolverOutboundEndpointResource) ModelObject() interface{} {
+	return &PrivateDnsResolverOutboundEndpointModel{}
+}
+
+func (r PrivateDnsResolverOutboundEndpointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return outboundendpoints.ValidateOutboundEndpointID
+}
+
+func (r PrivateDnsResolverOutboundEndpointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PrivateDnsResolverName,
+		},
+
+		"private_dns_resolver_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: dnsresolvers.ValidateDnsResolverID,
+		},
+
+		"location": location.Schema(),
+
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r PrivateDnsResolverOutboundEndpointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDnsResolverOutboundEndpointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model PrivateDnsResolverOutboundEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.PrivateDns.OutboundEndpointsClient
+			resolversClient := metadata.Client.PrivateDns.DnsResolversClient
+			subnetsClient := metadata.Client.Network.SubnetsClient
+
+			resolverId, err := dnsresolvers.ParseDnsResolverID(model.PrivateDnsResolverId)
+			if err != nil {
+				return err
+			}
+
+			id := outboundendpoints.NewOutboundEndpointID(resolverId.SubscriptionId, resolverId.ResourceGroupName, resolverId.DnsResolverName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			resolver, err := resolversClient.Get(ctx, *resolverId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *resolverId, err)
+			}
+			if resolver.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *resolverId)
+			}
+
+			if err := validatePrivateDnsResolverSubnet(ctx, subnetsClient, model.SubnetId, resolver.Model.Properties.VirtualNetwork.Id); err != nil {
+				return fmt.Errorf("validating the Subnet for %s: %+v", id, err)
+			}
+
+			properties := outboundendpoints.OutboundEndpoint{
+				Location: location.Normalize(model.Location),
+				Properties: outboundendpoints.OutboundEndpointProperties{
+					Subnet: &outboundendpoints.SubResource{
+						Id: model.SubnetId,
+					},
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverOutboundEndpointResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.OutboundEndpointsClient
+
+			id, err := outboundendpoints.ParseOutboundEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDnsResolverOutboundEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			properties := resp.Model
+			if properties == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				properties.Tags = &model.Tags
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverOutboundEndpointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.OutboundEndpointsClient
+
+			id, err := outboundendpoints.ParseOutboundEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateDnsResolverOutboundEndpointModel{
+				Name:                 id.OutboundEndpointName,
+				PrivateDnsResolverId: dnsresolvers.NewDnsResolverID(id.SubscriptionId, id.ResourceGroupName, id.DnsResolverName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if subnet := model.Properties.Subnet; subnet != nil {
+					state.SubnetId = subnet.Id
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDnsResolverOutboundEndpointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.OutboundEndpointsClient
+
+			id, err := outboundendpoints.ParseOutboundEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
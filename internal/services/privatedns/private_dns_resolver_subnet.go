@@ -0,0 +1,59 @@
+package privatedns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+// the Subnets used by the Inbound and Outbound Endpoints of a Private DNS Resolver must be delegated to the
+// Private DNS Resolver service - and must be within the Virtual Network the Private DNS Resolver is attached to
+const privateDnsResolverSubnetDelegationServiceName = "Microsoft.Network/dnsResolvers"
+
+func validatePrivateDnsResolverSubnet(ctx context.Context, client *network.SubnetsClient, subnetId, virtualNetworkId string) error {
+	id, err := networkParse.SubnetID(subnetId)
+	if err != nil {
+		return err
+	}
+
+	if !privateDnsResolverSubnetIsWithinVirtualNetwork(*id, virtualNetworkId) {
+		return fmt.Errorf("%s must be within the Virtual Network %q used by the Private DNS Resolver", *id, virtualNetworkId)
+	}
+
+	subnet, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if !subnetIsDelegatedToPrivateDnsResolver(subnet) {
+		return fmt.Errorf("%s must be delegated to %q - this can be configured using the `delegation` block within the `azurerm_subnet` resource", *id, privateDnsResolverSubnetDelegationServiceName)
+	}
+
+	return nil
+}
+
+func privateDnsResolverSubnetIsWithinVirtualNetwork(id networkParse.SubnetId, virtualNetworkId string) bool {
+	subnetVirtualNetworkId := networkParse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+	return strings.EqualFold(subnetVirtualNetworkId.ID(), virtualNetworkId)
+}
+
+func subnetIsDelegatedToPrivateDnsResolver(subnet network.Subnet) bool {
+	if subnet.SubnetPropertiesFormat == nil || subnet.SubnetPropertiesFormat.Delegations == nil {
+		return false
+	}
+
+	for _, delegation := range *subnet.SubnetPropertiesFormat.Delegations {
+		if delegation.ServiceDelegationPropertiesFormat == nil || delegation.ServiceDelegationPropertiesFormat.ServiceName == nil {
+			continue
+		}
+
+		if strings.EqualFold(*delegation.ServiceDelegationPropertiesFormat.ServiceName, privateDnsResolverSubnetDelegationServiceName) {
+			return true
+		}
+	}
+
+	return false
+}
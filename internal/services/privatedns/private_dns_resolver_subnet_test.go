@@ -0,0 +1,135 @@
+package privatedns
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestSubnetIsDelegatedToPrivateDnsResolver(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    network.Subnet
+		Expected bool
+	}{
+		{
+			Name:     "no properties",
+			Input:    network.Subnet{},
+			Expected: false,
+		},
+		{
+			Name: "no delegations",
+			Input: network.Subnet{
+				SubnetPropertiesFormat: &network.SubnetPropertiesFormat{},
+			},
+			Expected: false,
+		},
+		{
+			Name: "delegated to another service",
+			Input: network.Subnet{
+				SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
+					Delegations: &[]network.Delegation{
+						{
+							ServiceDelegationPropertiesFormat: &network.ServiceDelegationPropertiesFormat{
+								ServiceName: utils.String("Microsoft.Network/managedResolvers"),
+							},
+						},
+					},
+				},
+			},
+			Expected: false,
+		},
+		{
+			Name: "delegation without a service name",
+			Input: network.Subnet{
+				SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
+					Delegations: &[]network.Delegation{
+						{},
+					},
+				},
+			},
+			Expected: false,
+		},
+		{
+			Name: "delegated to private dns resolvers",
+			Input: network.Subnet{
+				SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
+					Delegations: &[]network.Delegation{
+						{
+							ServiceDelegationPropertiesFormat: &network.ServiceDelegationPropertiesFormat{
+								ServiceName: utils.String("Microsoft.Network/dnsResolvers"),
+							},
+						},
+					},
+				},
+			},
+			Expected: true,
+		},
+		{
+			Name: "delegated to multiple services including private dns resolvers",
+			Input: network.Subnet{
+				SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
+					Delegations: &[]network.Delegation{
+						{
+							ServiceDelegationPropertiesFormat: &network.ServiceDelegationPropertiesFormat{
+								ServiceName: utils.String("Microsoft.Sql/managedInstances"),
+							},
+						},
+						{
+							ServiceDelegationPropertiesFormat: &network.ServiceDelegationPropertiesFormat{
+								ServiceName: utils.String("microsoft.network/dnsresolvers"),
+							},
+						},
+					},
+				},
+			},
+			Expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := subnetIsDelegatedToPrivateDnsResolver(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestPrivateDnsResolverSubnetIsWithinVirtualNetwork(t *testing.T) {
+	subnetId := networkParse.NewSubnetID("12345678-1234-9876-4563-123456789012", "resGroup1", "vnet1", "subnet1")
+
+	testData := []struct {
+		VirtualNetworkId string
+		Expected         bool
+	}{
+		{
+			VirtualNetworkId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/vnet1",
+			Expected:         true,
+		},
+		{
+			VirtualNetworkId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/resgroup1/providers/Microsoft.Network/virtualNetworks/VNET1",
+			Expected:         true,
+		},
+		{
+			VirtualNetworkId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/vnet2",
+			Expected:         false,
+		},
+		{
+			VirtualNetworkId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup2/providers/Microsoft.Network/virtualNetworks/vnet1",
+			Expected:         false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.VirtualNetworkId)
+
+		actual := privateDnsResolverSubnetIsWithinVirtualNetwork(subnetId, v.VirtualNetworkId)
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package privatedns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2020-04-01-preview/dnsforwardingrulesets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/sdk/2020-04-01-preview/virtualnetworklinks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type PrivateDnsResolverVirtualNetworkLinkModel struct {
+	Name                   string            `tfschema:"name"`
+	DnsForwardingRulesetId string            `tfschema:"dns_forwarding_ruleset_id"`
+	VirtualNetworkId       string            `tfschema:"virtual_network_id"`
+	Metadata               map[string]string `tfschema:"metadata"`
+}
+
+type PrivateDnsResolverVirtualNetworkLinkResource struct{}
+
+var _ sdk.ResourceWithUpdate = PrivateDnsResolverVirtualNetworkLinkResource{}
+
+func (r PrivateDnsResolverVirtualNetworkLinkResource) ResourceType() string {
+	return "azurerm_private_dns_resolver_virtual_network_link"
+}
+
+func (r PrivateDnsResolverVirtualNetworkLinkResource) ModelObject() interface{} {
+	return &PrivateDnsResolverVirtualNetworkLinkModel{}
+}
+
+func (r PrivateDnsResolverVirtualNetworkLinkResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return virtualnetworklinks.ValidateVirtualNetworkLinkID
+}
+
+func (r PrivateDnsResolverVirtualNetworkLinkResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PrivateDnsResolverName,
+		},
+
+		"dns_forwarding_ruleset_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: dnsforwardingrulesets.ValidateDnsForwardingRulesetID,
+		},
+
+		"virtual_network_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.VirtualNetworkID,
+		},
+
+		"metadata": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r PrivateDnsResolverVirtualNetworkLinkResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateDnsResolverVirtualNetworkLinkResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model PrivateDnsResolverVirtualNetworkLinkModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.PrivateDns.ResolverVirtualNetworkLinksClient
+
+			rulesetId, err := dnsforwardingrulesets.ParseDnsForwardingRulesetID(model.DnsForwardingRulesetId)
+			if err != nil {
+				return err
+			}
+
+			id := virtualnetworklinks.NewVirtualNetworkLinkID(rulesetId.SubscriptionId, rulesetId.ResourceGroupName, rulesetId.DnsForwardingRulesetName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := virtualnetworklinks.VirtualNetworkLink{
+				Properties: virtualnetworklinks.VirtualNetworkLinkProperties{
+					Metadata: &model.Metadata,
+					VirtualNetwork: virtualnetworklinks.SubResource{
+						Id: model.VirtualNetworkId,
+					},
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverVirtualNetworkLinkResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.ResolverVirtualNetworkLinksClient
+
+			id, err := virtualnetworklinks.ParseVirtualNetworkLinkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDnsResolverVirtualNetworkLinkModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			properties := resp.Model
+			if properties == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			if metadata.ResourceData.HasChange("metadata") {
+				properties.Properties.Metadata = &model.Metadata
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsResolverVirtualNetworkLinkResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.ResolverVirtualNetworkLinksClient
+
+			id, err := virtualnetworklinks.ParseVirtualNetworkLinkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateDnsResolverVirtualNetworkLinkModel{
+				Name:                   id.VirtualNetworkLinkName,
+				DnsForwardingRulesetId: dnsforwardingrulesets.NewDnsForwardingRulesetID(id.SubscriptionId, id.ResourceGroupName, id.DnsForwardingRulesetName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.VirtualNetworkId = model.Properties.VirtualNetwork.Id
+
+				if model.Properties.Metadata != nil {
+					state.Metadata = *model.Properties.Metadata
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDnsResolverVirtualNetworkLinkResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.ResolverVirtualNetworkLinksClient
+
+			id, err := virtualnetworklinks.ParseVirtualNetworkLinkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
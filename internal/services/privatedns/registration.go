@@ -1,6 +1,7 @@
 package privatedns
 
 import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -39,3 +40,20 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_private_dns_zone_virtual_network_link": resourcePrivateDnsZoneVirtualNetworkLink(),
 	}
 }
+
+// DataSources returns a list of Data Sources supported by this Service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+// Resources returns a list of Resources supported by this Service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		PrivateDnsResolverResource{},
+		PrivateDnsResolverDnsForwardingRulesetResource{},
+		PrivateDnsResolverForwardingRuleResource{},
+		PrivateDnsResolverInboundEndpointResource{},
+		PrivateDnsResolverOutboundEndpointResource{},
+		PrivateDnsResolverVirtualNetworkLinkResource{},
+	}
+}
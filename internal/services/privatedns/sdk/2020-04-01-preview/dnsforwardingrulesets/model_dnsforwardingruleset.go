@@ -0,0 +1,12 @@
+package dnsforwardingrulesets
+
+type DnsForwardingRuleset struct {
+	Etag       *string                        `json:"etag,omitempty"`
+	Id         *string                        `json:"id,omitempty"`
+	Location   string                         `json:"location"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties DnsForwardingRulesetProperties `json:"properties"`
+	SystemData *SystemData                    `json:"systemData,omitempty"`
+	Tags       *map[string]string             `json:"tags,omitempty"`
+	Type       *string                        `json:"type,omitempty"`
+}
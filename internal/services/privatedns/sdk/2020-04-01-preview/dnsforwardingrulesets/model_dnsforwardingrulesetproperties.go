@@ -0,0 +1,7 @@
+package dnsforwardingrulesets
+
+type DnsForwardingRulesetProperties struct {
+	DnsResolverOutboundEndpoints *[]SubResource     `json:"dnsResolverOutboundEndpoints,omitempty"`
+	ProvisioningState            *ProvisioningState `json:"provisioningState,omitempty"`
+	ResourceGuid                 *string            `json:"resourceGuid,omitempty"`
+}
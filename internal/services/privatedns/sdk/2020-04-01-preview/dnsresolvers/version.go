@@ -0,0 +1,9 @@
+package dnsresolvers
+
+import "fmt"
+
+const defaultApiVersion = "2020-04-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/dnsresolvers/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package inboundendpoints
+
+import "github.com/Azure/go-autorest/autorest"
+
+type InboundEndpointsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewInboundEndpointsClientWithBaseURI(endpoint string) InboundEndpointsClient {
+	return InboundEndpointsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
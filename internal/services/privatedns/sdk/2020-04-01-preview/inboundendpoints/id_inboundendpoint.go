@@ -0,0 +1,137 @@
+package inboundendpoints
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = InboundEndpointId{}
+
+// InboundEndpointId is a struct representing the Resource ID for a Inbound Endpoint
+type InboundEndpointId struct {
+	SubscriptionId      string
+	ResourceGroupName   string
+	DnsResolverName     string
+	InboundEndpointName string
+}
+
+// NewInboundEndpointID returns a new InboundEndpointId struct
+func NewInboundEndpointID(subscriptionId string, resourceGroupName string, dnsResolverName string, inboundEndpointName string) InboundEndpointId {
+	return InboundEndpointId{
+		SubscriptionId:      subscriptionId,
+		ResourceGroupName:   resourceGroupName,
+		DnsResolverName:     dnsResolverName,
+		InboundEndpointName: inboundEndpointName,
+	}
+}
+
+// ParseInboundEndpointID parses 'input' into a InboundEndpointId
+func ParseInboundEndpointID(input string) (*InboundEndpointId, error) {
+	parser := resourceids.NewParserFromResourceIdType(InboundEndpointId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := InboundEndpointId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.DnsResolverName, ok = parsed.Parsed["dnsResolverName"]; !ok {
+		return nil, fmt.Errorf("the segment 'dnsResolverName' was not found in the resource id %q", input)
+	}
+
+	if id.InboundEndpointName, ok = parsed.Parsed["inboundEndpointName"]; !ok {
+		return nil, fmt.Errorf("the segment 'inboundEndpointName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseInboundEndpointIDInsensitively parses 'input' case-insensitively into a InboundEndpointId
+// note: this method should only be used for API response data and not user input
+func ParseInboundEndpointIDInsensitively(input string) (*InboundEndpointId, error) {
+	parser := resourceids.NewParserFromResourceIdType(InboundEndpointId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := InboundEndpointId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.DnsResolverName, ok = parsed.Parsed["dnsResolverName"]; !ok {
+		return nil, fmt.Errorf("the segment 'dnsResolverName' was not found in the resource id %q", input)
+	}
+
+	if id.InboundEndpointName, ok = parsed.Parsed["inboundEndpointName"]; !ok {
+		return nil, fmt.Errorf("the segment 'inboundEndpointName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateInboundEndpointID checks that 'input' can be parsed as a Inbound Endpoint ID
+func ValidateInboundEndpointID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseInboundEndpointID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Inbound Endpoint ID
+func (id InboundEndpointId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsResolvers/%s/inboundEndpoints/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.DnsResolverName, id.InboundEndpointName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Inbound Endpoint ID
+func (id InboundEndpointId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("subscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("resourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("providers", "providers", "providers"),
+		resourceids.ResourceProviderSegment("microsoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("dnsResolvers", "dnsResolvers", "dnsResolvers"),
+		resourceids.UserSpecifiedSegment("dnsResolverName", "dnsResolverValue"),
+		resourceids.StaticSegment("inboundEndpoints", "inboundEndpoints", "inboundEndpoints"),
+		resourceids.UserSpecifiedSegment("inboundEndpointName", "inboundEndpointValue"),
+	}
+}
+
+// String returns a human-readable description of this Inbound Endpoint ID
+func (id InboundEndpointId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Dns Resolver Name: %q", id.DnsResolverName),
+		fmt.Sprintf("Inbound Endpoint Name: %q", id.InboundEndpointName),
+	}
+	return fmt.Sprintf("Inbound Endpoint (%s)", strings.Join(components, "\n"))
+}
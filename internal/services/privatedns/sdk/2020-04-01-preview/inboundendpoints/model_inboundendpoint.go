@@ -0,0 +1,12 @@
+package inboundendpoints
+
+type InboundEndpoint struct {
+	Etag       *string                   `json:"etag,omitempty"`
+	Id         *string                   `json:"id,omitempty"`
+	Location   string                    `json:"location"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties InboundEndpointProperties `json:"properties"`
+	SystemData *SystemData               `json:"systemData,omitempty"`
+	Tags       *map[string]string        `json:"tags,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
@@ -0,0 +1,7 @@
+package inboundendpoints
+
+type InboundEndpointProperties struct {
+	IpConfigurations  *[]IpConfiguration `json:"ipConfigurations,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	ResourceGuid      *string            `json:"resourceGuid,omitempty"`
+}
@@ -0,0 +1,7 @@
+package inboundendpoints
+
+type IpConfiguration struct {
+	PrivateIpAddress          *string             `json:"privateIpAddress,omitempty"`
+	PrivateIpAllocationMethod *IpAllocationMethod `json:"privateIpAllocationMethod,omitempty"`
+	Subnet                    *SubResource        `json:"subnet,omitempty"`
+}
@@ -0,0 +1,15 @@
+package outboundendpoints
+
+import "github.com/Azure/go-autorest/autorest"
+
+type OutboundEndpointsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewOutboundEndpointsClientWithBaseURI(endpoint string) OutboundEndpointsClient {
+	return OutboundEndpointsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
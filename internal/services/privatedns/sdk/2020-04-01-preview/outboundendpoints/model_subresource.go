@@ -0,0 +1,5 @@
+package outboundendpoints
+
+type SubResource struct {
+	Id string `json:"id"`
+}
@@ -0,0 +1,15 @@
+package virtualnetworklinks
+
+import "github.com/Azure/go-autorest/autorest"
+
+type VirtualNetworkLinksClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewVirtualNetworkLinksClientWithBaseURI(endpoint string) VirtualNetworkLinksClient {
+	return VirtualNetworkLinksClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
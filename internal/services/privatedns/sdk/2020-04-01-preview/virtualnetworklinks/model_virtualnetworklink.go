@@ -0,0 +1,10 @@
+package virtualnetworklinks
+
+type VirtualNetworkLink struct {
+	Etag       *string                      `json:"etag,omitempty"`
+	Id         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties VirtualNetworkLinkProperties `json:"properties"`
+	SystemData *SystemData                  `json:"systemData,omitempty"`
+	Type       *string                      `json:"type,omitempty"`
+}
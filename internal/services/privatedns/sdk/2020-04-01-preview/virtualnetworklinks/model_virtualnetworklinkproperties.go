@@ -0,0 +1,7 @@
+package virtualnetworklinks
+
+type VirtualNetworkLinkProperties struct {
+	Metadata          *map[string]string `json:"metadata,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	VirtualNetwork    SubResource        `json:"virtualNetwork"`
+}
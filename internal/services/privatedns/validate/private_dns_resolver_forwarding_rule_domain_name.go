@@ -0,0 +1,36 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// PrivateDnsResolverForwardingRuleDomainName validates that the domain name of a Forwarding Rule is a
+// fully qualified domain name, which must end with a trailing period (e.g. `contoso.com.`)
+func PrivateDnsResolverForwardingRuleDomainName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if !strings.HasSuffix(value, ".") {
+		errors = append(errors, fmt.Errorf("%q must end with a period: %q", k, value))
+		return warnings, errors
+	}
+
+	for _, label := range strings.Split(strings.TrimSuffix(value, "."), ".") {
+		if len(label) < 1 || len(label) > 63 {
+			errors = append(errors, fmt.Errorf("each label of %q must be between 1 and 63 characters: %q", k, value))
+			return warnings, errors
+		}
+
+		if !regexp.MustCompile(`^[a-zA-Z\d]([a-zA-Z\d-]*[a-zA-Z\d])?$`).MatchString(label) {
+			errors = append(errors, fmt.Errorf("each label of %q must start and end with a letter or number and can only contain letters, numbers and hyphens: %q", k, value))
+			return warnings, errors
+		}
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,64 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPrivateDnsResolverForwardingRuleDomainName(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  ".",
+			Errors: 1,
+		},
+		{
+			Value:  "contoso.com",
+			Errors: 1,
+		},
+		{
+			Value:  "contoso.com.",
+			Errors: 0,
+		},
+		{
+			Value:  "onprem.contoso-1.com.",
+			Errors: 0,
+		},
+		{
+			Value:  "contoso..com.",
+			Errors: 1,
+		},
+		{
+			Value:  "-contoso.com.",
+			Errors: 1,
+		},
+		{
+			Value:  "con_toso.com.",
+			Errors: 1,
+		},
+		{
+			Value:  strings.Repeat("a", 63) + ".com.",
+			Errors: 0,
+		},
+		{
+			Value:  strings.Repeat("a", 64) + ".com.",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Value, func(t *testing.T) {
+			_, errors := PrivateDnsResolverForwardingRuleDomainName(tc.Value, "domain_name")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected PrivateDnsResolverForwardingRuleDomainName to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// PrivateDnsResolverName validates the name of a Private DNS Resolver or one of its child resources
+// (Inbound/Outbound Endpoints, DNS Forwarding Rulesets, Forwarding Rules and Virtual Network Links)
+func PrivateDnsResolverName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if len(value) < 1 || len(value) > 80 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 80 characters: %q", k, value))
+		return warnings, errors
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z\d]([a-zA-Z\d_-]*[a-zA-Z\d])?$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must start and end with a letter or number and can only contain letters, numbers, underscores and hyphens: %q", k, value))
+		return warnings, errors
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,56 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPrivateDnsResolverName(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "",
+			Errors: 1,
+		},
+		{
+			Value:  "a",
+			Errors: 0,
+		},
+		{
+			Value:  "example-resolver_1",
+			Errors: 0,
+		},
+		{
+			Value:  "-example",
+			Errors: 1,
+		},
+		{
+			Value:  "example_",
+			Errors: 1,
+		},
+		{
+			Value:  "example.resolver",
+			Errors: 1,
+		},
+		{
+			Value:  strings.Repeat("a", 80),
+			Errors: 0,
+		},
+		{
+			Value:  strings.Repeat("a", 81),
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Value, func(t *testing.T) {
+			_, errors := PrivateDnsResolverName(tc.Value, "name")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected PrivateDnsResolverName to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}